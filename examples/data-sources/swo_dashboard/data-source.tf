@@ -0,0 +1,11 @@
+data "swo_dashboard" "runbook" {
+  id = "6c2f8b1e-0e3a-4c5d-9b7a-2f1d3e4a5b6c"
+}
+
+output "runbook_widgets" {
+  value = data.swo_dashboard.runbook.widgets
+}
+
+output "runbook_definition" {
+  value = data.swo_dashboard.runbook.definition_json
+}
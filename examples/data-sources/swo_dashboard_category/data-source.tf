@@ -0,0 +1,3 @@
+data "swo_dashboard_category" "payments" {
+  name = "Payments"
+}
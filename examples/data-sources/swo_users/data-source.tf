@@ -0,0 +1,7 @@
+data "swo_users" "admins" {
+  role = "ADMIN"
+}
+
+data "swo_users" "by_email" {
+  email = "jane.doe@host.com"
+}
@@ -20,4 +20,16 @@ provider "swo" {
   # The 'SWO_BASE_URL' environment variable can be set as an alternative to using this field.
   # If 'base_url' is not provided, The provider will attempt to use the 'SWO_BASE_URL' environment variable.
   base_url = "https://api.na-01.cloud.solarwinds.com/v1/tfproxy"
+
+  # Tags that are added to every resource that has tags (optional).
+  default_tags = {
+    tags = {
+      "team" = "platform"
+    }
+  }
+
+  # Tags that are managed outside terraform, and are ignored by the provider (optional).
+  ignore_tags = {
+    key_prefixes = ["swo."]
+  }
 }
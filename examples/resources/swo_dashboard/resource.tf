@@ -160,3 +160,159 @@ resource "swo_dashboard" "metrics_dashboard" {
     }
   ]
 }
+
+resource "swo_dashboard" "typed_dashboard" {
+  name    = "My typed dashboard"
+  version = 2
+  widgets = [
+    {
+      key    = "response-time"
+      type   = "Kpi"
+      x      = 0
+      y      = 0
+      width  = 3
+      height = 6
+      kpi = {
+        title = "Response time"
+        unit  = "ms"
+        thresholds = [
+          {
+            value = 500
+            color = "#e23b3b"
+          }
+        ]
+        series = [
+          {
+            metric = "synthetics.https.response.time"
+            unit   = "ms"
+          }
+        ]
+      }
+    },
+    {
+      type   = "TimeSeries"
+      x      = 3
+      y      = 0
+      width  = 9
+      height = 6
+      time_series = {
+        title = "Response time by region"
+        unit  = "ms"
+        series = [
+          {
+            metric   = "synthetics.https.response.time"
+            group_by = ["probe.region"]
+            unit     = "ms"
+          }
+        ]
+      }
+    },
+    {
+      type   = "Proportional"
+      x      = 0
+      y      = 6
+      width  = 12
+      height = 6
+      proportional = {
+        title = "Slowest targets"
+        unit  = "ms"
+        series = [
+          {
+            metric          = "synthetics.http.response.time"
+            group_by        = ["synthetics.target"]
+            limit           = 10
+            limit_ascending = false
+          }
+        ]
+      }
+    },
+    {
+      type   = "Markdown"
+      x      = 0
+      y      = 12
+      width  = 12
+      height = 3
+      properties = jsonencode({
+        text = "## Runbook\n1. Check the response time by region.\n2. Check the error logs."
+      })
+    }
+  ]
+}
+
+resource "swo_dashboard" "runbook_dashboard" {
+  name    = "Checkout runbook"
+  version = 2
+  layout  = "auto"
+  widgets = [
+    {
+      key    = "a-steps"
+      row    = 0
+      span   = 4
+      height = 9
+      type   = "Markdown"
+      properties = jsonencode({
+        text = "## Runbook\n1. Check the error logs.\n2. Check the response time."
+      })
+    },
+    {
+      key  = "b-errors"
+      row  = 0
+      type = "Logs"
+      properties = jsonencode({
+        title = "Errors"
+        query = "service:checkout level:error"
+      })
+    },
+    {
+      key  = "c-response-time"
+      row  = 1
+      type = "TimeSeries"
+      time_series = {
+        title = "Response time"
+        unit  = "ms"
+        series = [
+          {
+            metric = "synthetics.https.response.time"
+            unit   = "ms"
+          }
+        ]
+      }
+    }
+  ]
+}
+
+resource "swo_dashboard" "exported_dashboard" {
+  name            = "Exported Dashboard"
+  definition_json = file("${path.module}/dashboard.json")
+}
+
+resource "swo_dashboard" "service_dashboard" {
+  name = "Checkout Service"
+  variables = [
+    {
+      name           = "service"
+      entity_type    = "Service"
+      tag_key        = "service.name"
+      default_values = ["checkout"]
+    }
+  ]
+  global_filters = [
+    {
+      name     = "by_service"
+      variable = "service"
+    }
+  ]
+  widgets = [
+    {
+      type   = "Logs"
+      x      = 0
+      y      = 0
+      width  = 12
+      height = 2
+      properties = jsonencode({
+        title = "Errors of {{var.service}}"
+        query = "{{filter.by_service}} level:error"
+      })
+    }
+  ]
+}
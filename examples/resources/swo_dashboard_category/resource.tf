@@ -0,0 +1,9 @@
+resource "swo_dashboard_category" "payments" {
+  name        = "Payments"
+  description = "Dashboards of the payment services."
+}
+
+resource "swo_dashboard" "payments_overview" {
+  name        = "Payments Overview"
+  category_id = swo_dashboard_category.payments.id
+}
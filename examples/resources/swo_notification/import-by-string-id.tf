@@ -0,0 +1,5 @@
+# Notifications can be imported by `{id}:{type}`, `{type}/{title}` or a unique `{title}`.
+import {
+  to = swo_notification.slack
+  id = "slack/Slack notification"
+}
@@ -0,0 +1,8 @@
+# Import by the backend id and notification type.
+terraform import swo_notification.slack 1234:slack
+
+# Import by the notification type and title.
+terraform import swo_notification.slack "slack/Slack notification"
+
+# Import by a title that is unique across all notification types.
+terraform import swo_notification.slack "Slack notification"
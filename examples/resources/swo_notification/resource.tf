@@ -29,6 +29,21 @@ resource "swo_notification" "email" {
   }
 }
 
+data "swo_users" "admins" {
+  role = "ADMIN"
+}
+
+resource "swo_notification" "email_admins" {
+  title       = "Email notification to admins"
+  description = "The email addresses are kept in sync with the users"
+  type        = "email"
+  settings = {
+    email = {
+      addresses = [for user in data.swo_users.admins.users : { id = user.id }]
+    }
+  }
+}
+
 resource "swo_notification" "msteams" {
   title       = "Microsoft Teams notification"
   description = "This is a description"
@@ -93,9 +108,10 @@ resource "swo_notification" "servicenow" {
 }
 
 resource "swo_notification" "slack" {
-  title       = "Slack notification"
-  description = "This is a description"
-  type        = "slack"
+  title           = "Slack notification"
+  description     = "This is a description"
+  type            = "slack"
+  verify_on_apply = true
   settings = {
     slack = {
       url = "https://hooks.slack.com/services/XXX/XXX/XXX"
@@ -132,6 +148,27 @@ resource "swo_notification" "test_webhook" {
   }
 }
 
+resource "swo_notification" "webhook_template" {
+  title       = "Webhook notification with a custom payload"
+  description = "This is a description"
+  type        = "webhook"
+  settings = {
+    webhook = {
+      method       = "POST"
+      url          = "https://webhook.example.com/"
+      content_type = "application/json"
+      headers = {
+        "X-Environment" = "production"
+      }
+      payload_template = jsonencode({
+        text     = "{{alert.name}} is {{alert.status}}"
+        severity = "{{alert.severity}}"
+        link     = "{{alert.url}}"
+      })
+    }
+  }
+}
+
 resource "swo_notification" "test_zapier" {
   title       = "Zapier notification"
   description = "This is a description"
@@ -141,4 +178,20 @@ resource "swo_notification" "test_zapier" {
       url = "https://hooks.zapier.com/hooks/catch/XXX"
     }
   }
-}
\ No newline at end of file
+}
+
+resource "swo_notification" "test_generic" {
+  title       = "Notification for an integration without typed settings"
+  description = "This is a description"
+  type        = "newIntegration"
+  settings = {
+    generic = {
+      type = "newIntegration"
+      json = jsonencode({
+        url   = "https://integration.example.com/"
+        token = "XXX"
+      })
+      sensitive_keys = ["token"]
+    }
+  }
+}
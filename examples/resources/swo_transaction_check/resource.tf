@@ -0,0 +1,65 @@
+resource "swo_transaction_check" "checkout" {
+  name        = "example-checkout"
+  description = "Adds an item to the cart and checks out."
+
+  tags = [
+    {
+      key   = "team"
+      value = "payments"
+    }
+  ]
+
+  test_definitions = {
+    test_from_location       = "REGION"
+    test_interval_in_seconds = 300
+
+    location_options = [
+      {
+        type  = "REGION"
+        value = "NA"
+      }
+    ]
+
+    platform_options = {
+      test_from_all = false
+      platforms     = ["AWS"]
+    }
+
+    window_size = {
+      width  = 1920
+      height = 1080
+    }
+  }
+
+  steps = [
+    {
+      command = "OPEN"
+      target  = "https://example.com/products/1"
+    },
+    {
+      command      = "CLICK"
+      target       = "css=button.add-to-cart"
+      display_text = "Add to cart"
+    },
+    {
+      command      = "TYPE"
+      target       = "id=email"
+      display_text = "Email"
+      value        = "synthetic@example.com"
+    },
+    {
+      command      = "CLICK"
+      target       = "css=button.checkout"
+      display_text = "Checkout"
+    },
+    {
+      command = "SWO_ASSERT_URL"
+      target  = "https://example.com/checkout/confirmation"
+    },
+    {
+      command = "SWO_ASSERT_TEXT_CONTAINS"
+      target  = "css=h1"
+      value   = "Thank you"
+    }
+  ]
+}
@@ -2,6 +2,13 @@ resource "swo_uri" "test" {
   name = "terraform-provider-swo example"
   host = "solarwinds.com"
 
+  tags = [
+    {
+      key   = "team"
+      value = "payments"
+    }
+  ]
+
   options = {
     is_ping_enabled = true
     is_tcp_enabled  = false
@@ -43,3 +50,32 @@ resource "swo_uri" "test" {
     }
   }
 }
+
+resource "swo_uri" "dns" {
+  name = "terraform-provider-swo dns example"
+  host = "internal.example.com"
+
+  options = {
+    is_ping_enabled = false
+    is_tcp_enabled  = false
+    is_dns_enabled  = true
+  }
+
+  dns_options = {
+    nameserver   = "10.0.0.2"
+    ip_to_expect = "10.0.1.15"
+  }
+
+  test_definitions = {
+    test_from_location = "REGION"
+
+    location_options = [
+      {
+        type  = "REGION"
+        value = "NA"
+      }
+    ]
+
+    test_interval_in_seconds = 300
+  }
+}
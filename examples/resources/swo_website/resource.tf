@@ -77,3 +77,42 @@ resource "swo_website" "test_website" {
     }
   }
 }
+
+resource "swo_website" "health_check" {
+  name = "example-health-check"
+  url  = "https://example.com/health"
+
+  monitoring = {
+    availability = {
+      protocols                = ["HTTPS"]
+      test_interval_in_seconds = 300
+      test_from_location       = "REGION"
+
+      location_options = [
+        {
+          type  = "REGION"
+          value = "NA"
+        }
+      ]
+
+      platform_options = {
+        test_from_all = false
+        platforms     = ["AWS"]
+      }
+
+      # The health endpoint is called with a POST request with a JSON body.
+      post_data = jsonencode({ check = "full" })
+
+      custom_headers = [
+        {
+          name  = "Authorization"
+          value = "Bearer [UPDATE WITH HEALTH CHECK TOKEN]"
+        },
+        {
+          name  = "Content-Type"
+          value = "application/json"
+        }
+      ]
+    }
+  }
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &dashboardCategoryDataSource{}
+	_ datasource.DataSourceWithConfigure = &dashboardCategoryDataSource{}
+)
+
+func NewDashboardCategoryDataSource() datasource.DataSource {
+	return &dashboardCategoryDataSource{}
+}
+
+// Defines the data source implementation.
+type dashboardCategoryDataSource struct {
+	gqlClient *gqlClient
+}
+
+func (d *dashboardCategoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_category"
+}
+
+func (d *dashboardCategoryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", "")
+		return
+	}
+	d.gqlClient = clients.GqlClient
+}
+
+func (d *dashboardCategoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfConfig dashboardCategoryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := tfConfig.Name.ValueString()
+	categories, err := searchDashboardCategories(ctx, d.gqlClient, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error reading dashboard categories. error: %s", err))
+		return
+	}
+
+	var found []dashboardCategory
+	for _, c := range categories {
+		if c.Name == name {
+			found = append(found, c)
+		}
+	}
+	if len(found) != 1 {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("expected one dashboard category named '%s', found %d", name, len(found)))
+		return
+	}
+
+	tfConfig.Id = types.StringValue(found[0].Id)
+	tfConfig.Type = types.StringValue(found[0].Type)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tfConfig)...)
+}
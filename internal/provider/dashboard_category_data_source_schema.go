@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The main Dashboard Category Data Source model that is derived from the schema.
+type dashboardCategoryDataSourceModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+func (d *dashboardCategoryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A terraform data source for looking up a dashboard category by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the dashboard category.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The id of the dashboard category.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the dashboard category, `system` or `custom`.",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	swoClient "github.com/solarwinds/swo-client-go/pkg/client"
+)
+
+const createCategoryMutation = `mutation createCategory($input: CreateCategoryInput!) {
+  createCategory(input: $input) {
+    code
+    success
+    message
+    category {
+      id
+      name
+      type
+    }
+  }
+}`
+
+const updateCategoryMutation = `mutation updateCategory($input: UpdateCategoryInput!) {
+  updateCategory(input: $input) {
+    code
+    success
+    message
+    category {
+      id
+      name
+      type
+    }
+  }
+}`
+
+const deleteCategoryMutation = `mutation deleteCategory($input: DeleteCategoryInput!) {
+  deleteCategory(input: $input) {
+    code
+    success
+    message
+  }
+}`
+
+const searchDashboardCategoriesQuery = `query searchDashboardCategories($inputs: SearchDashboardCategoriesInput!) {
+  dashboards {
+    categories {
+      search(inputs: $inputs) {
+        categories {
+          category {
+            id
+            name
+            type
+          }
+        }
+        totalCategoriesCount
+      }
+    }
+  }
+}`
+
+// The number of categories that are requested per page.
+const dashboardCategoriesPageSize = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &dashboardCategoryResource{}
+	_ resource.ResourceWithConfigure   = &dashboardCategoryResource{}
+	_ resource.ResourceWithImportState = &dashboardCategoryResource{}
+)
+
+func NewDashboardCategoryResource() resource.Resource {
+	return &dashboardCategoryResource{}
+}
+
+// Defines the resource implementation.
+type dashboardCategoryResource struct {
+	gqlClient *gqlClient
+}
+
+type dashboardCategory struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type dashboardCategoryMutationResponse struct {
+	gqlMutationResponse
+	Category *dashboardCategory `json:"category"`
+}
+
+// createDashboardCategory creates a dashboard category with the given name.
+func createDashboardCategory(ctx context.Context, client *gqlClient, name string) (*dashboardCategory, error) {
+	type createCategoryResponse struct {
+		CreateCategory dashboardCategoryMutationResponse `json:"createCategory"`
+	}
+
+	resp, err := gqlDo[createCategoryResponse](ctx, client, "createCategory", createCategoryMutation,
+		map[string]any{"input": map[string]any{"name": name}})
+	if err != nil {
+		return nil, err
+	}
+	if err = resp.CreateCategory.err("create dashboard category"); err != nil {
+		return nil, err
+	}
+	if resp.CreateCategory.Category == nil {
+		return nil, swoClient.ErrUnknown
+	}
+	return resp.CreateCategory.Category, nil
+}
+
+// updateDashboardCategory renames the dashboard category with the given id.
+func updateDashboardCategory(ctx context.Context, client *gqlClient, id string, name string) error {
+	type updateCategoryResponse struct {
+		UpdateCategory dashboardCategoryMutationResponse `json:"updateCategory"`
+	}
+
+	resp, err := gqlDo[updateCategoryResponse](ctx, client, "updateCategory", updateCategoryMutation,
+		map[string]any{"input": map[string]any{"id": id, "name": name}})
+	if err != nil {
+		return err
+	}
+	if err = resp.UpdateCategory.err("update dashboard category"); err != nil {
+		return err
+	}
+	if resp.UpdateCategory.Category == nil {
+		return swoClient.ErrNotFound
+	}
+	return nil
+}
+
+// deleteDashboardCategory deletes the dashboard category with the given id.
+func deleteDashboardCategory(ctx context.Context, client *gqlClient, id string) error {
+	type deleteCategoryResponse struct {
+		DeleteCategory gqlMutationResponse `json:"deleteCategory"`
+	}
+
+	resp, err := gqlDo[deleteCategoryResponse](ctx, client, "deleteCategory", deleteCategoryMutation,
+		map[string]any{"input": map[string]any{"id": id}})
+	if err != nil {
+		return err
+	}
+	return resp.DeleteCategory.err("delete dashboard category")
+}
+
+// searchDashboardCategories returns the dashboard categories, optionally filtered by name. The search can match
+// more categories than the ones with the given name.
+func searchDashboardCategories(ctx context.Context, client *gqlClient, name string) ([]dashboardCategory, error) {
+	type searchDashboardCategoriesResponse struct {
+		Dashboards struct {
+			Categories struct {
+				Search struct {
+					Categories []struct {
+						Category dashboardCategory `json:"category"`
+					} `json:"categories"`
+					TotalCategoriesCount int `json:"totalCategoriesCount"`
+				} `json:"search"`
+			} `json:"categories"`
+		} `json:"dashboards"`
+	}
+
+	var categories []dashboardCategory
+	for {
+		inputs := map[string]any{
+			"pagination": map[string]any{"limit": dashboardCategoriesPageSize, "offset": len(categories)},
+		}
+		if name != "" {
+			inputs["name"] = name
+		}
+
+		resp, err := gqlDo[searchDashboardCategoriesResponse](ctx, client, "searchDashboardCategories",
+			searchDashboardCategoriesQuery, map[string]any{"inputs": inputs})
+		if err != nil {
+			return nil, err
+		}
+
+		search := resp.Dashboards.Categories.Search
+		for _, c := range search.Categories {
+			categories = append(categories, c.Category)
+		}
+		if len(search.Categories) == 0 || len(categories) >= search.TotalCategoriesCount {
+			return categories, nil
+		}
+	}
+}
+
+// readDashboardCategory returns the dashboard category with the given id.
+func readDashboardCategory(ctx context.Context, client *gqlClient, id string) (*dashboardCategory, error) {
+	categories, err := searchDashboardCategories(ctx, client, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range categories {
+		if c.Id == id {
+			return &c, nil
+		}
+	}
+	return nil, swoClient.ErrNotFound
+}
+
+func (r *dashboardCategoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "dashboard_category"
+}
+
+func (r *dashboardCategoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, _ := req.ProviderData.(providerClients)
+	r.gqlClient = client.GqlClient
+}
+
+func (r *dashboardCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var tfPlan dashboardCategoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &tfPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the dashboard category...
+	category, err := createDashboardCategory(ctx, r.gqlClient, tfPlan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error creating dashboard category '%s'. error: %s", tfPlan.Name, err))
+		return
+	}
+
+	tfPlan.Id = types.StringValue(category.Id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, tfPlan)...)
+}
+
+func (r *dashboardCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var tfState dashboardCategoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &tfState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read the dashboard category...
+	category, err := readDashboardCategory(ctx, r.gqlClient, tfState.Id.ValueString())
+
+	if errors.Is(err, swoClient.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error reading dashboard category %s. error: %s", tfState.Id, err))
+		return
+	}
+
+	tfState.Name = types.StringValue(category.Name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, tfState)...)
+}
+
+func (r *dashboardCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var tfPlan, tfState dashboardCategoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &tfPlan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &tfState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the name of a category is stored by the API.
+	if !tfPlan.Name.Equal(tfState.Name) {
+		err := updateDashboardCategory(ctx, r.gqlClient, tfState.Id.ValueString(), tfPlan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("error updating dashboard category %s. error: %s", tfState.Id, err))
+			return
+		}
+	}
+
+	// Save to Terraform state.
+	tfPlan.Id = tfState.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, tfPlan)...)
+}
+
+func (r *dashboardCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var tfState dashboardCategoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &tfState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the dashboard category...
+	err := deleteDashboardCategory(ctx, r.gqlClient, tfState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error deleting dashboard category %s. error: %s", tfState.Id, err))
+	}
+}
+
+func (r *dashboardCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	swoClient "github.com/solarwinds/swo-client-go/pkg/client"
+)
+
+func TestAccDashboardCategoryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDashboardCategoryResourceConfig("test-acc swo-terraform-provider [CREATE_TEST]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("swo_dashboard_category.test", "id"),
+					resource.TestCheckResourceAttr("swo_dashboard_category.test", "name", "test-acc swo-terraform-provider [CREATE_TEST]"),
+					resource.TestCheckResourceAttrPair("swo_dashboard.test", "category_id", "swo_dashboard_category.test", "id"),
+					resource.TestCheckResourceAttrPair("data.swo_dashboard_category.test", "id", "swo_dashboard_category.test", "id"),
+					resource.TestCheckResourceAttr("data.swo_dashboard_category.test", "type", "custom"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "swo_dashboard_category.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"description"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccDashboardCategoryResourceConfig("test-acc swo-terraform-provider [UPDATE_TEST]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("swo_dashboard_category.test", "name", "test-acc swo-terraform-provider [UPDATE_TEST]"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDashboardCategoryResourceConfig(name string) string {
+	return providerConfig() + fmt.Sprintf(`
+	resource "swo_dashboard_category" "test" {
+		name        = %[1]q
+		description = "Dashboards of the payment services."
+	}
+
+	resource "swo_dashboard" "test" {
+		name        = %[1]q
+		category_id = swo_dashboard_category.test.id
+	}
+
+	data "swo_dashboard_category" "test" {
+		name = swo_dashboard_category.test.name
+	}`, name)
+}
+
+// fakeCategoryServer is an in-memory implementation of the dashboard category GraphQL operations.
+type fakeCategoryServer struct {
+	mu         sync.Mutex
+	categories []dashboardCategory
+}
+
+func (f *fakeCategoryServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		OperationName string `json:"operationName"`
+		Variables     struct {
+			Input  map[string]any `json:"input"`
+			Inputs struct {
+				Name       string `json:"name"`
+				Pagination struct {
+					Limit  int `json:"limit"`
+					Offset int `json:"offset"`
+				} `json:"pagination"`
+			} `json:"inputs"`
+		} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	mutationResponse := func(category any) map[string]any {
+		return map[string]any{"code": "200", "success": true, "message": "", "category": category}
+	}
+
+	var data map[string]any
+	switch req.OperationName {
+	case "createCategory":
+		category := dashboardCategory{
+			Id:   fmt.Sprintf("category-%d", len(f.categories)+1),
+			Name: req.Variables.Input["name"].(string),
+			Type: "custom",
+		}
+		f.categories = append(f.categories, category)
+		data = map[string]any{"createCategory": mutationResponse(category)}
+	case "updateCategory":
+		var updated any
+		for i := range f.categories {
+			if f.categories[i].Id == req.Variables.Input["id"] {
+				f.categories[i].Name = req.Variables.Input["name"].(string)
+				updated = f.categories[i]
+			}
+		}
+		data = map[string]any{"updateCategory": mutationResponse(updated)}
+	case "searchDashboardCategories":
+		var matching []map[string]any
+		for _, c := range f.categories {
+			if strings.Contains(c.Name, req.Variables.Inputs.Name) {
+				matching = append(matching, map[string]any{"category": c})
+			}
+		}
+		page := req.Variables.Inputs.Pagination
+		end := min(page.Offset+page.Limit, len(matching))
+		data = map[string]any{"dashboards": map[string]any{"categories": map[string]any{"search": map[string]any{
+			"categories":           matching[min(page.Offset, end):end],
+			"totalCategoriesCount": len(matching),
+		}}}}
+	default:
+		http.Error(w, "unknown operation "+req.OperationName, http.StatusBadRequest)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
+
+func TestDashboardCategoryOperations(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeCategoryServer{}
+	client := newTestGqlClient(t, fake.serveHTTP)
+
+	// More categories than fit in one page of the search.
+	for i := range dashboardCategoriesPageSize + 5 {
+		if _, err := createDashboardCategory(ctx, client, fmt.Sprintf("team %d", i)); err != nil {
+			t.Fatalf("createDashboardCategory() error = %s", err)
+		}
+	}
+	payments, err := createDashboardCategory(ctx, client, "payments")
+	if err != nil {
+		t.Fatalf("createDashboardCategory() error = %s", err)
+	}
+
+	category, err := readDashboardCategory(ctx, client, payments.Id)
+	if err != nil {
+		t.Fatalf("readDashboardCategory() error = %s", err)
+	}
+	if category.Name != "payments" {
+		t.Errorf("readDashboardCategory() name = %s, want payments", category.Name)
+	}
+
+	if err = updateDashboardCategory(ctx, client, payments.Id, "billing"); err != nil {
+		t.Fatalf("updateDashboardCategory() error = %s", err)
+	}
+	categories, err := searchDashboardCategories(ctx, client, "billing")
+	if err != nil {
+		t.Fatalf("searchDashboardCategories() error = %s", err)
+	}
+	if len(categories) != 1 || categories[0].Id != payments.Id {
+		t.Errorf("searchDashboardCategories() = %v", categories)
+	}
+
+	if _, err = readDashboardCategory(ctx, client, "unknown"); !errors.Is(err, swoClient.ErrNotFound) {
+		t.Errorf("readDashboardCategory() error = %v, want %v", err, swoClient.ErrNotFound)
+	}
+}
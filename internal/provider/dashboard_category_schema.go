@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The main Dashboard Category Resource model that is derived from the schema.
+type dashboardCategoryResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *dashboardCategoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A terraform resource for managing dashboard categories.",
+		Attributes: map[string]schema.Attribute{
+			"id": resourceIdAttribute(),
+			"name": schema.StringAttribute{
+				Description: "The name of the dashboard category.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the dashboard category. The SWO API doesn't store category " +
+					"descriptions, so the description is only kept in the Terraform state.",
+				Optional: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	swoClient "github.com/solarwinds/swo-client-go/pkg/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &dashboardDataSource{}
+	_ datasource.DataSourceWithConfigure = &dashboardDataSource{}
+)
+
+func NewDashboardDataSource() datasource.DataSource {
+	return &dashboardDataSource{}
+}
+
+// Defines the data source implementation.
+type dashboardDataSource struct {
+	client *swoClient.Client
+}
+
+func (d *dashboardDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+func (d *dashboardDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", "")
+		return
+	}
+	d.client = clients.SwoClient
+}
+
+func (d *dashboardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfConfig dashboardDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard, err := d.client.DashboardsService().Read(ctx, tfConfig.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error reading dashboard. id: %s, error: %s", tfConfig.Id, err))
+		return
+	}
+
+	tfConfig.Name = types.StringValue(dashboard.Name)
+	tfConfig.IsPrivate = types.BoolPointerValue(dashboard.IsPrivate)
+	tfConfig.CategoryId = types.StringNull()
+	if dashboard.Category != nil {
+		tfConfig.CategoryId = types.StringValue(dashboard.Category.Id)
+	}
+	tfConfig.Version = types.Int64Null()
+	if dashboard.Version != nil {
+		tfConfig.Version = types.Int64Value(int64(*dashboard.Version))
+	}
+
+	definition := dashboardDefinitionFromRead(dashboard)
+	var widgets []dashboardDataSourceWidgetModel
+	for _, w := range definition.Widgets {
+		layout := definition.layoutOf(w.Id)
+		if layout == nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("error reading dashboard. id: %s, error: %s", tfConfig.Id, newLayoutError(w.Id)))
+			return
+		}
+
+		props, err := json.Marshal(w.Properties)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("error reading dashboard. id: %s, error: %s", tfConfig.Id, newWidgetPropertiesError(err.Error(), w.Id)))
+			return
+		}
+
+		widgets = append(widgets, dashboardDataSourceWidgetModel{
+			Id:         types.StringValue(w.Id),
+			Type:       types.StringValue(w.Type),
+			X:          types.Int64Value(int64(layout.X)),
+			Y:          types.Int64Value(int64(layout.Y)),
+			Width:      types.Int64Value(int64(layout.Width)),
+			Height:     types.Int64Value(int64(layout.Height)),
+			Properties: types.StringValue(string(props)),
+		})
+	}
+	sort.Slice(widgets, func(i, j int) bool {
+		if !widgets[i].Y.Equal(widgets[j].Y) {
+			return widgets[i].Y.ValueInt64() < widgets[j].Y.ValueInt64()
+		}
+		return widgets[i].X.ValueInt64() < widgets[j].X.ValueInt64()
+	})
+
+	definitionJson, err := definition.normalized()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error reading dashboard. id: %s, error: %s", tfConfig.Id, err))
+		return
+	}
+	tfConfig.DefinitionJson = types.StringValue(definitionJson)
+
+	tfWidgets, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: DataSourceWidgetAttributeTypes()}, widgets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tfConfig.Widgets = tfWidgets
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tfConfig)...)
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The main Dashboard Data Source model that is derived from the schema.
+type dashboardDataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	IsPrivate      types.Bool   `tfsdk:"is_private"`
+	CategoryId     types.String `tfsdk:"category_id"`
+	Version        types.Int64  `tfsdk:"version"`
+	DefinitionJson types.String `tfsdk:"definition_json"`
+	Widgets        types.List   `tfsdk:"widgets"` //dashboardDataSourceWidgetModel
+}
+
+type dashboardDataSourceWidgetModel struct {
+	Id         types.String `tfsdk:"id"`
+	Type       types.String `tfsdk:"type"`
+	X          types.Int64  `tfsdk:"x"`
+	Y          types.Int64  `tfsdk:"y"`
+	Width      types.Int64  `tfsdk:"width"`
+	Height     types.Int64  `tfsdk:"height"`
+	Properties types.String `tfsdk:"properties"`
+}
+
+func DataSourceWidgetAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"type":       types.StringType,
+		"x":          types.Int64Type,
+		"y":          types.Int64Type,
+		"width":      types.Int64Type,
+		"height":     types.Int64Type,
+		"properties": types.StringType,
+	}
+}
+
+func (d *dashboardDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A terraform data source for exporting an existing dashboard.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the dashboard.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the dashboard.",
+				Computed:    true,
+			},
+			"is_private": schema.BoolAttribute{
+				Description: "True if the dashboard is restricted to the owner.",
+				Computed:    true,
+			},
+			"category_id": schema.StringAttribute{
+				Description: "The category that this dashboard is assigned to.",
+				Computed:    true,
+			},
+			"version": schema.Int64Attribute{
+				Description: "The version of the dashboard.",
+				Computed:    true,
+			},
+			"definition_json": schema.StringAttribute{
+				Description: "The normalized widgets and layout of the dashboard, in the format of the " +
+					"`definition_json` attribute of the `swo_dashboard` resource.",
+				Computed: true,
+			},
+			"widgets": schema.ListNestedAttribute{
+				Description: "The widgets of the dashboard, ordered from top to bottom and left to right. " +
+					"The widgets can be used as the `widgets` of a `swo_dashboard` resource.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The id of the widget.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the widget.",
+							Computed:    true,
+						},
+						"x": schema.Int64Attribute{
+							Description: "The X position of the widget.",
+							Computed:    true,
+						},
+						"y": schema.Int64Attribute{
+							Description: "The Y position of the widget.",
+							Computed:    true,
+						},
+						"width": schema.Int64Attribute{
+							Description: "The width of the widget in columns.",
+							Computed:    true,
+						},
+						"height": schema.Int64Attribute{
+							Description: "The height of the widget.",
+							Computed:    true,
+						},
+						"properties": schema.StringAttribute{
+							Description: "A JSON encoded string that defines the widget configuration.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	swoClient "github.com/solarwinds/swo-client-go/pkg/client"
+)
+
+var errDashboardDefinition = errors.New("invalid dashboard definition")
+
+// dashboardDefinition is the dashboard JSON format of the SWO dashboard export. Only the widgets and their
+// layout are part of the definition, the other fields of the export are ignored.
+type dashboardDefinition struct {
+	Widgets []dashboardDefinitionWidget `json:"widgets"`
+	Layout  []dashboardDefinitionLayout `json:"layout"`
+}
+
+type dashboardDefinitionWidget struct {
+	Id         string `json:"id"`
+	Type       string `json:"type"`
+	Properties any    `json:"properties"`
+}
+
+type dashboardDefinitionLayout struct {
+	Id     string `json:"id"`
+	X      int    `json:"x"`
+	Y      int    `json:"y"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// parseDashboardDefinition parses a dashboard definition and checks that every widget has an id and a layout.
+func parseDashboardDefinition(definitionJson string) (*dashboardDefinition, error) {
+	var definition dashboardDefinition
+	if err := json.Unmarshal([]byte(definitionJson), &definition); err != nil {
+		return nil, fmt.Errorf("%w: %s", errDashboardDefinition, err)
+	}
+
+	for _, w := range definition.Widgets {
+		if w.Id == "" {
+			return nil, fmt.Errorf("%w: the %s widget has no id", errDashboardDefinition, w.Type)
+		}
+		if definition.layoutOf(w.Id) == nil {
+			return nil, fmt.Errorf("%w: %s", errDashboardDefinition, newLayoutError(w.Id))
+		}
+	}
+	return &definition, nil
+}
+
+func (d *dashboardDefinition) layoutOf(id string) *dashboardDefinitionLayout {
+	for i := range d.Layout {
+		if d.Layout[i].Id == id {
+			return &d.Layout[i]
+		}
+	}
+	return nil
+}
+
+// normalized returns the definition as JSON with the widgets and layouts sorted by id, so definitions that only
+// differ in formatting or order are equal.
+func (d *dashboardDefinition) normalized() (string, error) {
+	sort.Slice(d.Widgets, func(i, j int) bool { return d.Widgets[i].Id < d.Widgets[j].Id })
+	sort.Slice(d.Layout, func(i, j int) bool { return d.Layout[i].Id < d.Layout[j].Id })
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// inputs returns the widgets and layouts of the definition for the dashboards API.
+func (d *dashboardDefinition) inputs() ([]swoClient.WidgetInput, []swoClient.LayoutInput) {
+	widgets := make([]swoClient.WidgetInput, len(d.Widgets))
+	layouts := make([]swoClient.LayoutInput, len(d.Widgets))
+	for i, w := range d.Widgets {
+		props := w.Properties
+		widgets[i] = swoClient.WidgetInput{Id: w.Id, Type: w.Type, Properties: &props}
+
+		layout := d.layoutOf(w.Id)
+		layouts[i] = swoClient.LayoutInput{
+			Id:     w.Id,
+			X:      layout.X,
+			Y:      layout.Y,
+			Width:  layout.Width,
+			Height: layout.Height,
+		}
+	}
+	return widgets, layouts
+}
+
+// dashboardDefinitionFromRead returns the definition of a dashboard returned by the dashboards API.
+func dashboardDefinitionFromRead(dashboard *swoClient.ReadDashboardResult) *dashboardDefinition {
+	definition := &dashboardDefinition{
+		Widgets: []dashboardDefinitionWidget{},
+		Layout:  []dashboardDefinitionLayout{},
+	}
+	for _, w := range dashboard.Widgets {
+		var props any
+		if w.Properties != nil {
+			props = *w.Properties
+		}
+		definition.Widgets = append(definition.Widgets, dashboardDefinitionWidget{Id: w.Id, Type: w.Type, Properties: props})
+	}
+	for _, l := range dashboard.Layout {
+		definition.Layout = append(definition.Layout, dashboardDefinitionLayout{
+			Id:     l.Id,
+			X:      l.X,
+			Y:      l.Y,
+			Width:  l.Width,
+			Height: l.Height,
+		})
+	}
+	return definition
+}
+
+// dashboardDefinitionType is the type of a dashboard definition. Definitions that only differ in formatting,
+// field order or widget order are semantically equal, so the configured definition is kept in the state.
+type dashboardDefinitionType struct {
+	basetypes.StringType
+}
+
+var _ basetypes.StringTypable = dashboardDefinitionType{}
+
+func (t dashboardDefinitionType) String() string {
+	return "dashboardDefinitionType"
+}
+
+func (t dashboardDefinitionType) Equal(o attr.Type) bool {
+	other, ok := o.(dashboardDefinitionType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t dashboardDefinitionType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return dashboardDefinitionValue{StringValue: in}, nil
+}
+
+func (t dashboardDefinitionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return dashboardDefinitionValue{StringValue: stringValue}, nil
+}
+
+func (t dashboardDefinitionType) ValueType(_ context.Context) attr.Value {
+	return dashboardDefinitionValue{}
+}
+
+// dashboardDefinitionValue is the value of a dashboard definition.
+type dashboardDefinitionValue struct {
+	basetypes.StringValue
+}
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = dashboardDefinitionValue{}
+	_ xattr.ValidateableAttribute                = dashboardDefinitionValue{}
+)
+
+func newDashboardDefinitionValue(definitionJson string) dashboardDefinitionValue {
+	return dashboardDefinitionValue{StringValue: types.StringValue(definitionJson)}
+}
+
+func newDashboardDefinitionNull() dashboardDefinitionValue {
+	return dashboardDefinitionValue{StringValue: types.StringNull()}
+}
+
+func (v dashboardDefinitionValue) Type(_ context.Context) attr.Type {
+	return dashboardDefinitionType{}
+}
+
+func (v dashboardDefinitionValue) Equal(o attr.Value) bool {
+	other, ok := o.(dashboardDefinitionValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true when both definitions have the same widgets and layouts.
+func (v dashboardDefinitionValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(dashboardDefinitionValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T.", v, newValuable))
+		return false, diags
+	}
+
+	normalized, err := normalizeDashboardDefinition(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newNormalized, err := normalizeDashboardDefinition(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return normalized == newNormalized, diags
+}
+
+// ValidateAttribute checks that the definition can be parsed.
+func (v dashboardDefinitionValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := parseDashboardDefinition(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Dashboard Definition", err.Error())
+	}
+}
+
+// normalizeDashboardDefinition returns the normalized JSON of a dashboard definition.
+func normalizeDashboardDefinition(definitionJson string) (string, error) {
+	definition, err := parseDashboardDefinition(definitionJson)
+	if err != nil {
+		return "", err
+	}
+	return definition.normalized()
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The definition of a dashboard as exported in the dashboard editor.
+const testDashboardDefinition = `{
+	"name": "Runbook",
+	"widgets": [
+		{"id": "b", "type": "Logs", "properties": {"title": "Errors", "query": "level:error"}},
+		{"id": "a", "type": "Markdown", "properties": {"text": "# Runbook"}}
+	],
+	"layout": [
+		{"id": "a", "x": 0, "y": 0, "width": 12, "height": 2},
+		{"id": "b", "x": 0, "y": 2, "width": 12, "height": 6}
+	]
+}`
+
+func TestDashboardDefinitionNormalized(t *testing.T) {
+	definition, err := parseDashboardDefinition(testDashboardDefinition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := definition.normalized()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"widgets":[{"id":"a","type":"Markdown","properties":{"text":"# Runbook"}},` +
+		`{"id":"b","type":"Logs","properties":{"query":"level:error","title":"Errors"}}],` +
+		`"layout":[{"id":"a","x":0,"y":0,"width":12,"height":2},{"id":"b","x":0,"y":2,"width":12,"height":6}]}`
+	if got != want {
+		t.Errorf("normalized() = %s, want %s", got, want)
+	}
+}
+
+func TestDashboardDefinitionSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	normalized, err := normalizeDashboardDefinition(testDashboardDefinition)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		definitionJson string
+		want           bool
+	}{
+		"normalized":     {normalized, true},
+		"reordered":      {`{"layout":[{"id":"b","x":0,"y":2,"width":12,"height":6},{"x":0,"id":"a","y":0,"width":12,"height":2}],"widgets":[{"id":"a","type":"Markdown","properties":{"text":"# Runbook"}},{"id":"b","type":"Logs","properties":{"title":"Errors","query":"level:error"}}]}`, true},
+		"moved":          {`{"widgets":[{"id":"a","type":"Markdown","properties":{"text":"# Runbook"}},{"id":"b","type":"Logs","properties":{"query":"level:error","title":"Errors"}}],"layout":[{"id":"a","x":4,"y":0,"width":12,"height":2},{"id":"b","x":0,"y":2,"width":12,"height":6}]}`, false},
+		"changed widget": {`{"widgets":[{"id":"a","type":"Markdown","properties":{"text":"# Errors"}},{"id":"b","type":"Logs","properties":{"query":"level:error","title":"Errors"}}],"layout":[{"id":"a","x":0,"y":0,"width":12,"height":2},{"id":"b","x":0,"y":2,"width":12,"height":6}]}`, false},
+		"invalid":        {`{"widgets": [`, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, diags := newDashboardDefinitionValue(testDashboardDefinition).
+				StringSemanticEquals(ctx, newDashboardDefinitionValue(tc.definitionJson))
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if got != tc.want {
+				t.Errorf("StringSemanticEquals() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseDashboardDefinitionErrors(t *testing.T) {
+	for name, definitionJson := range map[string]string{
+		"invalid json":   `{"widgets": [`,
+		"missing id":     `{"widgets": [{"type": "Markdown"}], "layout": []}`,
+		"missing layout": `{"widgets": [{"id": "a", "type": "Markdown"}], "layout": []}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseDashboardDefinition(definitionJson); !errors.Is(err, errDashboardDefinition) {
+				t.Errorf("parseDashboardDefinition() error = %v, want %v", err, errDashboardDefinition)
+			}
+		})
+	}
+}
+
+func TestDashboardDefinitionFakeServer(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newFakeDashboardServer(t)
+	r := &dashboardResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	tfSchema := schemaResp.Schema
+
+	// The definition is planned as it is exported, without being normalized.
+	definitionJson := newDashboardDefinitionValue(testDashboardDefinition)
+	plan := testDashboardPlan(t, tfSchema, nil)
+	plan.SetAttribute(ctx, path.Root("widgets"), types.SetNull(types.ObjectType{AttrTypes: WidgetAttributeTypes()}))
+	plan.SetAttribute(ctx, path.Root("definition_json"), definitionJson)
+
+	createResp := resource.CreateResponse{
+		State: tfsdk.State{Schema: tfSchema, Raw: tftypes.NewValue(tfSchema.Type().TerraformType(ctx), nil)},
+	}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned errors: %v", createResp.Diagnostics)
+	}
+	var created dashboardResourceModel
+	createResp.State.Get(ctx, &created)
+
+	// The widgets are kept in the definition, and reading them back keeps the configured definition.
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned errors: %v", readResp.Diagnostics)
+	}
+	var read dashboardResourceModel
+	readResp.State.Get(ctx, &read)
+	if !read.Widgets.IsNull() {
+		t.Errorf("expected no widgets in the state, got %s", read.Widgets)
+	}
+	if !read.DefinitionJson.Equal(definitionJson) {
+		t.Errorf("definition changed after Read:\n%s\n%s", definitionJson, read.DefinitionJson)
+	}
+
+	// Widgets moved in the UI show up in the definition.
+	fake.dashboard(created.Id.ValueString())["layout"].([]any)[0].(map[string]any)["x"] = 4
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	readResp.State.Get(ctx, &read)
+	if read.DefinitionJson.Equal(definitionJson) {
+		t.Error("the definition wasn't updated after the layout changed")
+	}
+
+	// The data source exports the dashboard in both formats.
+	d := &dashboardDataSource{client: client}
+	var dsSchemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &dsSchemaResp)
+	configState := tfsdk.State{Schema: dsSchemaResp.Schema, Raw: tftypes.NewValue(dsSchemaResp.Schema.Type().TerraformType(ctx), nil)}
+	configState.SetAttribute(ctx, path.Root("id"), created.Id)
+	config := tfsdk.Config{Schema: dsSchemaResp.Schema, Raw: configState.Raw}
+	dsResp := datasource.ReadResponse{State: tfsdk.State{Schema: dsSchemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &dsResp)
+	if dsResp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned errors: %v", dsResp.Diagnostics)
+	}
+
+	var exported dashboardDataSourceModel
+	dsResp.State.Get(ctx, &exported)
+	if !exported.DefinitionJson.Equal(read.DefinitionJson.StringValue) {
+		t.Errorf("data source definition_json = %s, want %s", exported.DefinitionJson, read.DefinitionJson)
+	}
+	var widgets []dashboardDataSourceWidgetModel
+	exported.Widgets.ElementsAs(ctx, &widgets, false)
+	if len(widgets) != 2 || widgets[0].Type.ValueString() != "Markdown" ||
+		!widgets[1].Properties.Equal(types.StringValue(`{"query":"level:error","title":"Errors"}`)) {
+		t.Errorf("unexpected data source widgets %v", widgets)
+	}
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	dashboardLayoutAuto   = "auto"
+	dashboardLayoutManual = "manual"
+
+	// The number of columns of the dashboard grid.
+	dashboardColumns = 12
+	// The default height of a widget in auto layout, in the height units of a pre-version-2 dashboard.
+	dashboardDefaultWidgetHeight = 2
+)
+
+// widgetHeightScale returns the number of height units per pre-version-2 height unit. Version 2 triples the
+// granularity of widget heights.
+func widgetHeightScale(version types.Int64) int64 {
+	if version.ValueInt64() >= 2 {
+		return 3
+	}
+	return 1
+}
+
+// widgetLabel returns a description of the widget for diagnostics.
+func (m *dashboardWidgetModel) widgetLabel() string {
+	if !m.Key.IsNull() {
+		return fmt.Sprintf("widget '%s'", m.Key.ValueString())
+	}
+	return fmt.Sprintf("%s widget at x=%d, y=%d", m.Type.ValueString(), m.X.ValueInt64(), m.Y.ValueInt64())
+}
+
+// validateManualLayout checks that the widgets are inside the grid and don't overlap. The y positions and heights
+// are scaled from the height units of the dashboard version to the height units of version 2 before they're checked.
+func validateManualLayout(widgets []dashboardWidgetModel, version types.Int64, diags *diag.Diagnostics) {
+	heightScale := widgetHeightScale(types.Int64Value(2)) / widgetHeightScale(version)
+
+	type area struct {
+		label               string
+		x, y, width, height int64
+	}
+
+	var areas []area
+	for _, w := range widgets {
+		if !w.Row.IsNull() || !w.Span.IsNull() {
+			diags.AddAttributeError(path.Root("widgets"), "Invalid Widget Layout",
+				fmt.Sprintf("The %s sets 'row' or 'span', which are only used when 'layout' is 'auto'.", w.widgetLabel()))
+		}
+
+		missing := false
+		for i, value := range []types.Int64{w.X, w.Y, w.Width, w.Height} {
+			if value.IsNull() {
+				diags.AddAttributeError(path.Root("widgets"), "Invalid Widget Layout",
+					fmt.Sprintf("The %s widget is missing '%s', which is required unless 'layout' is 'auto'.",
+						w.Type.ValueString(), []string{"x", "y", "width", "height"}[i]))
+			}
+			missing = missing || value.IsNull() || value.IsUnknown()
+		}
+		if missing {
+			continue
+		}
+
+		a := area{
+			label:  w.widgetLabel(),
+			x:      w.X.ValueInt64(),
+			y:      w.Y.ValueInt64() * heightScale,
+			width:  w.Width.ValueInt64(),
+			height: w.Height.ValueInt64() * heightScale,
+		}
+		if a.x < 0 || a.y < 0 || a.width < 1 || a.height < 1 || a.x+a.width > dashboardColumns {
+			diags.AddAttributeError(path.Root("widgets"), "Invalid Widget Layout",
+				fmt.Sprintf("The %s is outside the dashboard grid. 'x' and 'y' can't be negative, 'width' and "+
+					"'height' must be at least 1, and 'x' + 'width' can't be more than %d.", a.label, dashboardColumns))
+			continue
+		}
+
+		for _, o := range areas {
+			if a.x < o.x+o.width && o.x < a.x+a.width && a.y < o.y+o.height && o.y < a.y+a.height {
+				diags.AddAttributeError(path.Root("widgets"), "Invalid Widget Layout",
+					fmt.Sprintf("The %s overlaps the %s.", a.label, o.label))
+			}
+		}
+		areas = append(areas, a)
+	}
+}
+
+// validateAutoLayout checks that the widgets have the row and key used to place them, and that the spans of
+// each row fit in the grid.
+func validateAutoLayout(widgets []dashboardWidgetModel, diags *diag.Diagnostics) {
+	columnsByRow := map[int64]int64{}
+	for _, w := range widgets {
+		if !w.X.IsNull() || !w.Y.IsNull() || !w.Width.IsNull() {
+			diags.AddAttributeError(path.Root("widgets"), "Invalid Widget Layout",
+				fmt.Sprintf("The %s sets 'x', 'y' or 'width', which are computed when 'layout' is 'auto'. "+
+					"Use 'row' and 'span' instead.", w.widgetLabel()))
+		}
+		if w.Row.IsNull() || w.Key.IsNull() {
+			diags.AddAttributeError(path.Root("widgets"), "Invalid Widget Layout",
+				fmt.Sprintf("The %s widget must set 'row' and 'key' when 'layout' is 'auto'.", w.Type.ValueString()))
+			continue
+		}
+
+		span := int64(1)
+		if !w.Span.IsNull() {
+			span = w.Span.ValueInt64()
+		}
+		columnsByRow[w.Row.ValueInt64()] += span
+	}
+
+	for row, columns := range columnsByRow {
+		if columns > dashboardColumns {
+			diags.AddAttributeError(path.Root("widgets"), "Invalid Widget Layout",
+				fmt.Sprintf("The widgets of row %d need %d columns, but the dashboard has %d.",
+					row, columns, dashboardColumns))
+		}
+	}
+}
+
+// packAutoLayout sets the position and size of the widgets from their rows and spans. Rows are placed from top
+// to bottom, and the widgets of a row from left to right in the order of their keys. A row is as high as its
+// highest widget.
+func packAutoLayout(widgets []dashboardWidgetModel, version types.Int64) {
+	rows := map[int64][]*dashboardWidgetModel{}
+	var rowNumbers []int64
+	for i := range widgets {
+		row := widgets[i].Row.ValueInt64()
+		if _, found := rows[row]; !found {
+			rowNumbers = append(rowNumbers, row)
+		}
+		rows[row] = append(rows[row], &widgets[i])
+	}
+	sort.Slice(rowNumbers, func(i, j int) bool { return rowNumbers[i] < rowNumbers[j] })
+
+	y := int64(0)
+	for _, row := range rowNumbers {
+		rowWidgets := rows[row]
+		sort.Slice(rowWidgets, func(i, j int) bool {
+			return rowWidgets[i].Key.ValueString() < rowWidgets[j].Key.ValueString()
+		})
+
+		// The columns that are left after the explicit spans are shared by the other widgets of the row.
+		freeColumns, withoutSpan := int64(dashboardColumns), int64(0)
+		for _, w := range rowWidgets {
+			if w.Span.IsNull() {
+				withoutSpan++
+			} else {
+				freeColumns -= w.Span.ValueInt64()
+			}
+		}
+
+		x, rowHeight := int64(0), int64(0)
+		for _, w := range rowWidgets {
+			width := w.Span.ValueInt64()
+			if w.Span.IsNull() {
+				width = freeColumns / withoutSpan
+				if freeColumns%withoutSpan > 0 {
+					width++
+				}
+				freeColumns -= width
+				withoutSpan--
+			}
+
+			if w.Height.IsNull() || w.Height.IsUnknown() {
+				w.Height = types.Int64Value(dashboardDefaultWidgetHeight * widgetHeightScale(version))
+			}
+
+			w.X = types.Int64Value(x)
+			w.Y = types.Int64Value(y)
+			w.Width = types.Int64Value(width)
+			x += width
+			rowHeight = max(rowHeight, w.Height.ValueInt64())
+		}
+		y += rowHeight
+	}
+}
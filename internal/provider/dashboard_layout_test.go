@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testLayoutWidget(x, y, width, height int64) dashboardWidgetModel {
+	return dashboardWidgetModel{
+		Type:   types.StringValue("Markdown"),
+		X:      types.Int64Value(x),
+		Y:      types.Int64Value(y),
+		Width:  types.Int64Value(width),
+		Height: types.Int64Value(height),
+	}
+}
+
+func testAutoLayoutWidget(key string, row int64, span types.Int64) dashboardWidgetModel {
+	return dashboardWidgetModel{
+		Key:    types.StringValue(key),
+		Type:   types.StringValue("Markdown"),
+		X:      types.Int64Unknown(),
+		Y:      types.Int64Unknown(),
+		Width:  types.Int64Unknown(),
+		Height: types.Int64Unknown(),
+		Row:    types.Int64Value(row),
+		Span:   span,
+	}
+}
+
+func TestValidateManualLayout(t *testing.T) {
+	tests := []struct {
+		name    string
+		widgets []dashboardWidgetModel
+		version types.Int64
+		wantErr bool
+	}{
+		{
+			name:    "side by side",
+			widgets: []dashboardWidgetModel{testLayoutWidget(0, 0, 4, 2), testLayoutWidget(4, 0, 8, 2)},
+		},
+		{
+			name:    "stacked",
+			widgets: []dashboardWidgetModel{testLayoutWidget(0, 0, 12, 2), testLayoutWidget(0, 2, 12, 6)},
+		},
+		{
+			name:    "stacked in version 2 heights",
+			widgets: []dashboardWidgetModel{testLayoutWidget(0, 0, 12, 6), testLayoutWidget(0, 6, 12, 3)},
+			version: types.Int64Value(2),
+		},
+		{
+			name:    "overlap",
+			widgets: []dashboardWidgetModel{testLayoutWidget(0, 0, 4, 2), testLayoutWidget(3, 1, 4, 2)},
+			wantErr: true,
+		},
+		{
+			name:    "overlap in version 2 heights",
+			widgets: []dashboardWidgetModel{testLayoutWidget(0, 0, 12, 6), testLayoutWidget(0, 5, 12, 3)},
+			version: types.Int64Value(2),
+			wantErr: true,
+		},
+		{
+			name:    "wider than the grid",
+			widgets: []dashboardWidgetModel{testLayoutWidget(8, 0, 6, 2)},
+			wantErr: true,
+		},
+		{
+			name:    "negative position",
+			widgets: []dashboardWidgetModel{testLayoutWidget(0, -1, 4, 2)},
+			wantErr: true,
+		},
+		{
+			name: "missing position",
+			widgets: []dashboardWidgetModel{{
+				Type: types.StringValue("Markdown"), Width: types.Int64Value(4), Height: types.Int64Value(2),
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateManualLayout(tt.widgets, tt.version, &diags)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("validateManualLayout() errors = %v, wantErr %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAutoLayout(t *testing.T) {
+	var diags diag.Diagnostics
+	validateAutoLayout([]dashboardWidgetModel{
+		{Key: types.StringValue("a"), Row: types.Int64Value(0), Span: types.Int64Value(8)},
+		{Key: types.StringValue("b"), Row: types.Int64Value(0), Span: types.Int64Value(4)},
+		{Key: types.StringValue("c"), Row: types.Int64Value(1)},
+	}, &diags)
+	if diags.HasError() {
+		t.Errorf("validateAutoLayout() returned errors: %v", diags)
+	}
+
+	diags = nil
+	validateAutoLayout([]dashboardWidgetModel{
+		{Key: types.StringValue("a"), Row: types.Int64Value(0), Span: types.Int64Value(8)},
+		{Key: types.StringValue("b"), Row: types.Int64Value(0), Span: types.Int64Value(4)},
+		{Key: types.StringValue("c"), Row: types.Int64Value(0)},
+	}, &diags)
+	if !diags.HasError() {
+		t.Error("validateAutoLayout() accepted a row that is wider than the grid")
+	}
+}
+
+func TestPackAutoLayout(t *testing.T) {
+	widgets := []dashboardWidgetModel{
+		testAutoLayoutWidget("b-latency", 0, types.Int64Null()),
+		testAutoLayoutWidget("a-summary", 0, types.Int64Value(3)),
+		testAutoLayoutWidget("c-errors", 0, types.Int64Null()),
+		testAutoLayoutWidget("d-logs", 5, types.Int64Null()),
+	}
+	widgets[3].Height = types.Int64Value(9)
+
+	packAutoLayout(widgets, types.Int64Value(2))
+
+	want := map[string][4]int64{
+		"a-summary": {0, 0, 3, 6},
+		"b-latency": {3, 0, 5, 6},
+		"c-errors":  {8, 0, 4, 6},
+		"d-logs":    {0, 6, 12, 9},
+	}
+	for _, w := range widgets {
+		got := [4]int64{w.X.ValueInt64(), w.Y.ValueInt64(), w.Width.ValueInt64(), w.Height.ValueInt64()}
+		if got != want[w.Key.ValueString()] {
+			t.Errorf("%s placed at %v, want %v", w.Key.ValueString(), got, want[w.Key.ValueString()])
+		}
+	}
+
+	// The packed widgets must be a valid manual layout.
+	for i := range widgets {
+		widgets[i].Row = types.Int64Null()
+		widgets[i].Span = types.Int64Null()
+	}
+	var diags diag.Diagnostics
+	validateManualLayout(widgets, types.Int64Value(2), &diags)
+	if diags.HasError() {
+		t.Errorf("packed widgets are not a valid layout: %v", diags)
+	}
+}
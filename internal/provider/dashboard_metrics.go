@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const metricsByNamesQuery = `query metricsByNames($names: [String!]!) {
+  metrics {
+    byNames(names: $names) {
+      name
+    }
+  }
+}`
+
+const (
+	metricValidationWarning = "warning"
+	metricValidationError   = "error"
+	metricValidationOff     = "off"
+)
+
+// existingMetricNames returns the names of the given metrics that exist.
+func existingMetricNames(ctx context.Context, client *gqlClient, names []string) (map[string]bool, error) {
+	type metricsByNamesResponse struct {
+		Metrics struct {
+			ByNames []struct {
+				Name string `json:"name"`
+			} `json:"byNames"`
+		} `json:"metrics"`
+	}
+
+	resp, err := gqlDo[metricsByNamesResponse](ctx, client, "metricsByNames", metricsByNamesQuery,
+		map[string]any{"names": names})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(resp.Metrics.ByNames))
+	for _, m := range resp.Metrics.ByNames {
+		existing[m.Name] = true
+	}
+	return existing, nil
+}
+
+// collectMetricNames adds the values of the 'metric' fields of the JSON value to the names. Metric names with
+// dashboard variable references are skipped, as they are only known after the references are replaced. The
+// metrics that are referenced in query strings, e.g. of Logs widgets, aren't parsed and aren't checked.
+func collectMetricNames(value any, names map[string]bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, element := range v {
+			if metric, ok := element.(string); ok && key == "metric" {
+				if metric != "" && !strings.Contains(metric, "{{") {
+					names[metric] = true
+				}
+				continue
+			}
+			collectMetricNames(element, names)
+		}
+	case []any:
+		for _, element := range v {
+			collectMetricNames(element, names)
+		}
+	}
+}
+
+// widgetMetricNames returns the names of the metrics that are referenced by the widget, and the attribute that
+// configures them.
+func (m *dashboardWidgetModel) widgetMetricNames(ctx context.Context, diags *diag.Diagnostics) (map[string]bool, string) {
+	attribute := "properties"
+	switch m.typedWidgetType() {
+	case widgetTypeKpi:
+		attribute = "kpi"
+	case widgetTypeTimeSeries:
+		attribute = "time_series"
+	case widgetTypeProportional:
+		attribute = "proportional"
+	}
+
+	props, err := convertObject[any](m.widgetProperties(ctx, diags))
+	if diags.HasError() {
+		return nil, attribute
+	}
+	if err != nil {
+		diags.AddError("swo provider error", fmt.Sprintf("error reading the widget properties: %s", err))
+		return nil, attribute
+	}
+
+	names := map[string]bool{}
+	collectMetricNames(*props, names)
+	return names, attribute
+}
+
+// validateWidgetMetrics checks that the metrics referenced by the widgets exist. Unknown metrics are reported as
+// warnings, or as errors when asked to. Composite metrics that don't exist are skipped, as they may be created by
+// the same apply.
+func (r *dashboardResource) validateWidgetMetrics(ctx context.Context, tfWidgets types.Set, asErrors bool, diags *diag.Diagnostics) {
+	type widgetMetrics struct {
+		path  path.Path
+		names map[string]bool
+	}
+
+	var widgets []widgetMetrics
+	allNames := map[string]bool{}
+	for _, element := range tfWidgets.Elements() {
+		obj, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		var w dashboardWidgetModel
+		diags.Append(obj.As(ctx, &w, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return
+		}
+		if !isFullyKnown(ctx, w.Properties) || !isFullyKnown(ctx, w.Kpi) ||
+			!isFullyKnown(ctx, w.TimeSeries) || !isFullyKnown(ctx, w.Proportional) {
+			continue
+		}
+
+		names, attribute := w.widgetMetricNames(ctx, diags)
+		if diags.HasError() {
+			return
+		}
+		for name := range names {
+			allNames[name] = true
+		}
+		widgets = append(widgets, widgetMetrics{
+			path:  path.Root("widgets").AtSetValue(obj).AtName(attribute),
+			names: names,
+		})
+	}
+	if len(allNames) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(allNames))
+	for name := range allNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	existing, err := existingMetricNames(ctx, r.gqlClient, names)
+	if err != nil {
+		diags.AddWarning("Widget Metrics Not Validated",
+			fmt.Sprintf("The metrics of the dashboard widgets couldn't be validated: %s", err))
+		return
+	}
+
+	for _, w := range widgets {
+		var unknown []string
+		for name := range w.names {
+			if !existing[name] && !strings.HasPrefix(name, compositePrefix) {
+				unknown = append(unknown, name)
+			}
+		}
+		sort.Strings(unknown)
+
+		for _, name := range unknown {
+			summary := "Unknown Widget Metric"
+			detail := fmt.Sprintf("The metric '%s' doesn't exist.", name)
+			if asErrors {
+				diags.AddAttributeError(w.path, summary, detail)
+			} else {
+				diags.AddAttributeWarning(w.path, summary, detail)
+			}
+		}
+	}
+}
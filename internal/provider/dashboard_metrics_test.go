@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newTestMetricsClient(t *testing.T, existing ...string) *gqlClient {
+	return newTestGqlClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables struct {
+				Names []string `json:"names"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		metrics := []map[string]any{}
+		for _, name := range req.Variables.Names {
+			for _, e := range existing {
+				if name == e {
+					metrics = append(metrics, map[string]any{"name": name})
+				}
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"metrics": map[string]any{"byNames": metrics}},
+		})
+	})
+}
+
+func TestValidateWidgetMetrics(t *testing.T) {
+	ctx := context.Background()
+	r := &dashboardResource{gqlClient: newTestMetricsClient(t, "synthetics.https.response.time")}
+
+	// The composite metric doesn't exist yet, as if it was created by the same apply.
+	tfWidgets, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: WidgetAttributeTypes()}, []dashboardWidgetModel{
+		testRawWidget(widgetTypeTimeSeries, 0, `{"dataSource":{"properties":{"series":[`+
+			`{"metric":"synthetics.https.response.time"},{"metric":"composite.checkout.errors"}]}}}`),
+		testRawWidget(widgetTypeKpi, 4, `{"dataSource":{"properties":{"series":[{"metric":"no.such.metric"}]}}}`),
+		testRawWidget("Markdown", 8, `{"text":"# Runbook"}`),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	r.validateWidgetMetrics(ctx, tfWidgets, false, &diags)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected one warning for the unknown metric, got %v", diags)
+	}
+	if withPath, ok := diags[0].(diag.DiagnosticWithPath); !ok || withPath.Path().String() == "" {
+		t.Errorf("the warning has no attribute path: %v", diags[0])
+	}
+
+	diags = nil
+	r.validateWidgetMetrics(ctx, tfWidgets, true, &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("expected one error for the unknown metric, got %v", diags)
+	}
+}
@@ -19,14 +19,33 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &dashboardResource{}
-	_ resource.ResourceWithConfigure   = &dashboardResource{}
-	_ resource.ResourceWithImportState = &dashboardResource{}
+	_ resource.Resource                   = &dashboardResource{}
+	_ resource.ResourceWithConfigure      = &dashboardResource{}
+	_ resource.ResourceWithImportState    = &dashboardResource{}
+	_ resource.ResourceWithValidateConfig = &dashboardResource{}
+	_ resource.ResourceWithModifyPlan     = &dashboardResource{}
 
 	errLayoutMissing    = errors.New("layout missing for widget id")
 	errWidgetProperties = errors.New("widget properties error")
 )
 
+const (
+	// The private state key of the widget ids by widget key.
+	dashboardWidgetIdsKey = "widget_ids"
+	// The private state key of the id of a dashboard that was replaced after a privacy change, and couldn't
+	// be deleted.
+	dashboardReplacedIdKey = "replaced_dashboard_id"
+)
+
+// privateStateGetter and privateStateSetter are implemented by the private state of requests and responses.
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
 func newLayoutError(id string) error {
 	return fmt.Errorf("%w: %s", errLayoutMissing, id)
 }
@@ -41,7 +60,8 @@ func NewDashboardResource() resource.Resource {
 
 // Defines the resource implementation.
 type dashboardResource struct {
-	client *swoClient.Client
+	client    *swoClient.Client
+	gqlClient *gqlClient
 }
 
 func (r *dashboardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,10 +71,310 @@ func (r *dashboardResource) Metadata(ctx context.Context, req resource.MetadataR
 func (r *dashboardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, _ := req.ProviderData.(providerClients)
 	r.client = client.SwoClient
+	r.gqlClient = client.GqlClient
+}
+
+func (r *dashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var tfWidgets types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("widgets"), &tfWidgets)...)
+	if resp.Diagnostics.HasError() || !isFullyKnown(ctx, tfWidgets) {
+		return
+	}
+
+	var widgets []dashboardWidgetModel
+	resp.Diagnostics.Append(tfWidgets.ElementsAs(ctx, &widgets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys := map[string]bool{}
+	for _, w := range widgets {
+		if !w.Key.IsNull() && !w.Key.IsUnknown() {
+			if keys[w.Key.ValueString()] {
+				resp.Diagnostics.AddAttributeError(path.Root("widgets"), "Invalid Widget",
+					fmt.Sprintf("The widget key '%s' is used by more than one widget.", w.Key.ValueString()))
+			}
+			keys[w.Key.ValueString()] = true
+		}
+
+		typedType := w.typedWidgetType()
+		if typedType == "" || w.Type.IsUnknown() || w.Type.ValueString() == typedType {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("widgets"), "Invalid Widget",
+			fmt.Sprintf("A widget of type '%s' can't be configured with the typed block for '%s' widgets.",
+				w.Type.ValueString(), typedType))
+	}
+
+	var tfVariables, tfFilters types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("variables"), &tfVariables)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("global_filters"), &tfFilters)...)
+	if !resp.Diagnostics.HasError() && isFullyKnown(ctx, tfVariables) && isFullyKnown(ctx, tfFilters) {
+		var variables []dashboardVariableModel
+		var filters []dashboardGlobalFilterModel
+		resp.Diagnostics.Append(tfVariables.ElementsAs(ctx, &variables, false)...)
+		resp.Diagnostics.Append(tfFilters.ElementsAs(ctx, &filters, false)...)
+		if !resp.Diagnostics.HasError() {
+			validateDashboardTemplate(variables, filters, widgets, &resp.Diagnostics)
+		}
+	}
+
+	var layout types.String
+	var version types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("layout"), &layout)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version"), &version)...)
+	if resp.Diagnostics.HasError() || layout.IsUnknown() {
+		return
+	}
+
+	if layout.ValueString() == dashboardLayoutAuto {
+		validateAutoLayout(widgets, &resp.Diagnostics)
+	} else if !version.IsUnknown() {
+		validateManualLayout(widgets, version, &resp.Diagnostics)
+	}
+}
+
+// ModifyPlan places the widgets when the layout is auto, and reuses the ids of the widgets with a key, so the plan
+// shows the widgets as changed instead of replaced. The metrics of the widgets are checked against the metrics API.
+func (r *dashboardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Nothing to plan on destroy.
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		r.modifyPlanPrivacy(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var tfWidgets types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("widgets"), &tfWidgets)...)
+	if resp.Diagnostics.HasError() || tfWidgets.IsNull() || tfWidgets.IsUnknown() {
+		return
+	}
+
+	var layout types.String
+	var version types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("layout"), &layout)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("version"), &version)...)
+	widgetIds := getWidgetIds(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planWidgets []dashboardWidgetModel
+	resp.Diagnostics.Append(tfWidgets.ElementsAs(ctx, &planWidgets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The defaults of the typed widget blocks are set here rather than with a Default in the schema, which
+	// doesn't play well with sets of objects and produces unstable plans. Check https://tinyurl.com/unstable-plans
+	// for more details.
+	for wIdx := range planWidgets {
+		planWidgets[wIdx].setDefaults(ctx, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if layout.ValueString() == dashboardLayoutAuto && !version.IsUnknown() &&
+		!slices.ContainsFunc(planWidgets, func(w dashboardWidgetModel) bool {
+			return w.Row.IsUnknown() || w.Span.IsUnknown() || w.Key.IsUnknown()
+		}) {
+		packAutoLayout(planWidgets, version)
+	}
+
+	for wIdx := range planWidgets {
+		planW := &planWidgets[wIdx]
+		if planW.Key.IsNull() || planW.Key.IsUnknown() || !planW.Id.IsUnknown() {
+			continue
+		}
+		if id, found := widgetIds[planW.Key.ValueString()]; found {
+			planW.Id = types.StringValue(id)
+		}
+	}
+
+	tfWidgets, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: WidgetAttributeTypes()}, planWidgets)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("widgets"), tfWidgets)...)
+
+	var metricValidation types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("metric_validation"), &metricValidation)...)
+	if resp.Diagnostics.HasError() || r.gqlClient == nil || metricValidation.ValueString() == metricValidationOff {
+		return
+	}
+	r.validateWidgetMetrics(ctx, tfWidgets, metricValidation.ValueString() == metricValidationError, &resp.Diagnostics)
+}
+
+// modifyPlanPrivacy warns that a privacy change recreates the dashboard, and plans the new id of the dashboard.
+func (r *dashboardResource) modifyPlanPrivacy(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var planIsPrivate, stateIsPrivate types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("is_private"), &planIsPrivate)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("is_private"), &stateIsPrivate)...)
+	if resp.Diagnostics.HasError() || planIsPrivate.IsUnknown() ||
+		planIsPrivate.ValueBool() == stateIsPrivate.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(path.Root("is_private"), "Dashboard Will Be Recreated",
+		"The SWO API can't change the privacy of a dashboard. The dashboard is recreated with the same name and "+
+			"widgets, and the old dashboard is deleted. The id and the URL of the dashboard will change.")
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("owner_id"), types.StringUnknown())...)
+}
+
+// deleteReplacedDashboard deletes the dashboard that was replaced after a privacy change. A dashboard that
+// can't be deleted is recorded in the private state, so the delete is retried on the next refresh.
+func (r *dashboardResource) deleteReplacedDashboard(ctx context.Context, id string, private privateStateSetter, diags *diag.Diagnostics) {
+	err := r.client.DashboardsService().Delete(ctx, id)
+	if err == nil || errors.Is(err, swoClient.ErrNotFound) {
+		return
+	}
+
+	diags.AddWarning("Replaced Dashboard Not Deleted",
+		fmt.Sprintf("The dashboard %s was replaced by a dashboard with a different privacy, but it couldn't be "+
+			"deleted: %s. The delete is retried on the next refresh, or when the dashboard is destroyed.", id, err))
+	data, err := json.Marshal(id)
+	if err != nil {
+		diags.AddError("swo provider error",
+			fmt.Sprintf("error saving the replaced dashboard id to the private state: %s", err))
+		return
+	}
+	diags.Append(private.SetKey(ctx, dashboardReplacedIdKey, data)...)
+}
+
+// getReplacedDashboardId returns the id of the replaced dashboard that is recorded in the private state, or an
+// empty string if there is none.
+func getReplacedDashboardId(ctx context.Context, private privateStateGetter, diags *diag.Diagnostics) string {
+	data, d := private.GetKey(ctx, dashboardReplacedIdKey)
+	diags.Append(d...)
+	if diags.HasError() || len(data) == 0 {
+		return ""
+	}
+
+	var id string
+	if err := json.Unmarshal(data, &id); err != nil {
+		diags.AddError("swo provider error",
+			fmt.Sprintf("error reading the replaced dashboard id from the private state: %s", err))
+		return ""
+	}
+	return id
+}
+
+// retryDeleteReplacedDashboard deletes the replaced dashboard that is recorded in the private state.
+func (r *dashboardResource) retryDeleteReplacedDashboard(ctx context.Context, getter privateStateGetter, setter privateStateSetter, diags *diag.Diagnostics) {
+	id := getReplacedDashboardId(ctx, getter, diags)
+	if diags.HasError() || id == "" {
+		return
+	}
+
+	err := r.client.DashboardsService().Delete(ctx, id)
+	if err != nil && !errors.Is(err, swoClient.ErrNotFound) {
+		diags.AddWarning("Replaced Dashboard Not Deleted",
+			fmt.Sprintf("The dashboard %s was replaced by a dashboard with a different privacy, but it still "+
+				"couldn't be deleted: %s.", id, err))
+		return
+	}
+	diags.Append(setter.SetKey(ctx, dashboardReplacedIdKey, nil)...)
+}
+
+// getWidgetIds returns the ids of the widgets by widget key that are stored in the private state.
+func getWidgetIds(ctx context.Context, private privateStateGetter, diags *diag.Diagnostics) map[string]string {
+	widgetIds := map[string]string{}
+	data, d := private.GetKey(ctx, dashboardWidgetIdsKey)
+	diags.Append(d...)
+	if diags.HasError() || len(data) == 0 {
+		return widgetIds
+	}
+
+	if err := json.Unmarshal(data, &widgetIds); err != nil {
+		diags.AddError("swo provider error",
+			fmt.Sprintf("error reading the widget ids from the private state: %s", err))
+	}
+	return widgetIds
+}
+
+// setWidgetIds stores the ids of the widgets by widget key in the private state. The private state is left
+// untouched when there are no widget keys before and after the change.
+func setWidgetIds(ctx context.Context, private privateStateSetter, previous map[string]string, current map[string]string, diags *diag.Diagnostics) {
+	if len(previous) == 0 && len(current) == 0 {
+		return
+	}
+
+	data, err := json.Marshal(current)
+	if err != nil {
+		diags.AddError("swo provider error",
+			fmt.Sprintf("error saving the widget ids to the private state: %s", err))
+		return
+	}
+	diags.Append(private.SetKey(ctx, dashboardWidgetIdsKey, data)...)
+}
+
+// widgetIdsByKey returns the ids of the widgets that have a key.
+func widgetIdsByKey(ctx context.Context, tfWidgets types.Set, diags *diag.Diagnostics) map[string]string {
+	var widgets []dashboardWidgetModel
+	diags.Append(tfWidgets.ElementsAs(ctx, &widgets, false)...)
+
+	widgetIds := map[string]string{}
+	for _, w := range widgets {
+		if !w.Key.IsNull() && !w.Id.IsNull() && !w.Id.IsUnknown() {
+			widgetIds[w.Key.ValueString()] = w.Id.ValueString()
+		}
+	}
+	return widgetIds
+}
+
+// reuseStateWidgetIds sets the ids of the plan widgets without an id that are unchanged in the state.
+func reuseStateWidgetIds(ctx context.Context, plan *dashboardResourceModel, state dashboardResourceModel, diags *diag.Diagnostics) {
+	var planWidgets, stateWidgets []dashboardWidgetModel
+	diags.Append(plan.Widgets.ElementsAs(ctx, &planWidgets, false)...)
+	diags.Append(state.Widgets.ElementsAs(ctx, &stateWidgets, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	used := make([]bool, len(stateWidgets))
+	for wIdx := range planWidgets {
+		planW := &planWidgets[wIdx]
+		if !planW.Id.IsUnknown() {
+			continue
+		}
+		for sIdx, stateW := range stateWidgets {
+			if !used[sIdx] && planW.equalIgnoringId(stateW) {
+				planW.Id = stateW.Id
+				used[sIdx] = true
+				break
+			}
+		}
+	}
+
+	tfWidgets, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: WidgetAttributeTypes()}, planWidgets)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	plan.Widgets = tfWidgets
 }
 
-// Creates new WidgetInputs and LayoutInputs from plan widget data.
-func widgetsFromPlan(ctx context.Context, plan dashboardResourceModel, diags *diag.Diagnostics) ([]swoClient.WidgetInput, []swoClient.LayoutInput) {
+// Creates new WidgetInputs and LayoutInputs from plan widget data. The ids of the widgets are set in the plan.
+// Widgets with a key reuse the id stored for the key, and new widgets get a new id. A dashboard that is configured
+// with a definition uses the widgets and layouts of the definition.
+func widgetsFromPlan(ctx context.Context, plan *dashboardResourceModel, widgetIds map[string]string, diags *diag.Diagnostics) ([]swoClient.WidgetInput, []swoClient.LayoutInput) {
+	if !plan.DefinitionJson.IsNull() {
+		definition, err := parseDashboardDefinition(plan.DefinitionJson.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("definition_json"), "Invalid Dashboard Definition", err.Error())
+			return nil, nil
+		}
+		widgets, layouts := definition.inputs()
+		return widgets, layouts
+	}
 
 	var planWidgets []dashboardWidgetModel
 	d := plan.Widgets.ElementsAs(ctx, &planWidgets, false)
@@ -63,21 +383,32 @@ func widgetsFromPlan(ctx context.Context, plan dashboardResourceModel, diags *di
 		return nil, nil
 	}
 
+	template := dashboardTemplateOf(ctx, plan, diags)
+	if diags.HasError() {
+		return nil, nil
+	}
+
 	widgets := make([]swoClient.WidgetInput, len(planWidgets))
 	layouts := make([]swoClient.LayoutInput, len(planWidgets))
 
 	for wIdx := range planWidgets {
 		planW := &planWidgets[wIdx]
-		id := uuid.NewString()
+		id, found := widgetIds[planW.Key.ValueString()]
+		if planW.Key.IsNull() || !found {
+			id = planW.Id.ValueString()
+		}
+		if id == "" {
+			id = uuid.NewString()
+		}
+		planW.Id = types.StringValue(id)
 
-		// Marshal the json encoded properties string to an object.
-		var props any
-		err := json.Unmarshal([]byte(planW.Properties.ValueString()), &props)
-		if err != nil {
-			diags.AddError("swo provider error",
-				fmt.Sprintf("convert plan to API error: %s", err))
+		props := planW.widgetProperties(ctx, diags)
+		if diags.HasError() {
 			return nil, nil
 		}
+		if planW.typedWidgetType() == "" {
+			props = template.render(props)
+		}
 
 		widgets[wIdx] = swoClient.WidgetInput{
 			Id:         id,
@@ -94,12 +425,20 @@ func widgetsFromPlan(ctx context.Context, plan dashboardResourceModel, diags *di
 		}
 	}
 
+	tfWidgets, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: WidgetAttributeTypes()}, planWidgets)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil
+	}
+	plan.Widgets = tfWidgets
+
 	return widgets, layouts
 }
 
 // Sets the computed values of the dashboard models with the values returned from the Create request.
 func setDashboardValuesFromCreate(ctx context.Context, dashboard *swoClient.CreateDashboardResult, plan *dashboardResourceModel, diags *diag.Diagnostics) {
 	plan.Id = types.StringValue(dashboard.Id)
+	plan.OwnerId = types.StringPointerValue(dashboard.OwnerId)
 
 	// the client may modify 'version' value
 	if dashboard.Version == nil {
@@ -116,6 +455,7 @@ func setDashboardValuesFromCreate(ctx context.Context, dashboard *swoClient.Crea
 		return
 	}
 
+	matched := make([]bool, len(planWidgets))
 	for _, w := range dashboard.Widgets {
 		lIdx := slices.IndexFunc(dashboard.Layout, func(l swoClient.CreateDashboardLayout) bool { return l.Id == w.Id })
 		if lIdx <= -1 {
@@ -127,18 +467,28 @@ func setDashboardValuesFromCreate(ctx context.Context, dashboard *swoClient.Crea
 		// The layout that will give us the widget coordinates for comparison to the plan.
 		layout := &dashboard.Layout[lIdx]
 
-		// We need to compare the properties of the plan widget with what is returned from the server
-		// to reconcile the server data with the plan data. A widget's id in the plan is temporary, and
-		// there isn't any single value we can use to make a match.
+		// The server keeps the widget ids that are sent in the request, so a widget is matched by id first.
+		wIdx := slices.IndexFunc(planWidgets, func(planW dashboardWidgetModel) bool {
+			return planW.Id.Equal(types.StringValue(w.Id))
+		})
+		if wIdx >= 0 && !matched[wIdx] {
+			matched[wIdx] = true
+			continue
+		}
+
+		// When the server assigned a new id, we need to compare the properties of the plan widget with
+		// what is returned from the server to reconcile the server data with the plan data.
 		for wIdx := range planWidgets {
 			planW := &planWidgets[wIdx]
-			if planW.Type.Equal(types.StringValue(w.Type)) &&
+			if !matched[wIdx] &&
+				planW.Type.Equal(types.StringValue(w.Type)) &&
 				planW.X.Equal(types.Int64Value(int64(layout.X))) &&
 				planW.Y.Equal(types.Int64Value(int64(layout.Y))) &&
 				planW.Width.Equal(types.Int64Value(int64(layout.Width))) &&
 				planW.Height.Equal(types.Int64Value(int64(layout.Height))) {
 				// Widget properties are equal, so we assume it must be the one we're looking for.
 				planW.Id = types.StringValue(w.Id)
+				matched[wIdx] = true
 				break
 			}
 		}
@@ -162,6 +512,7 @@ func setDashboardValuesFromRead(ctx context.Context, dashboard *swoClient.ReadDa
 	if dashboard.IsPrivate != nil {
 		state.IsPrivate = types.BoolValue(*dashboard.IsPrivate)
 	}
+	state.OwnerId = types.StringPointerValue(dashboard.OwnerId)
 
 	if dashboard.Version == nil {
 		state.Version = types.Int64PointerValue(nil)
@@ -170,13 +521,40 @@ func setDashboardValuesFromRead(ctx context.Context, dashboard *swoClient.ReadDa
 		state.Version = types.Int64PointerValue(&dVersion)
 	}
 
+	// A dashboard that is configured with a definition keeps its widgets in the definition.
+	if !state.DefinitionJson.IsNull() {
+		definitionJson, err := dashboardDefinitionFromRead(dashboard).normalized()
+		if err != nil {
+			diags.AddError("swo provider error",
+				fmt.Sprintf("error updating local state for dashboard: %s, id: %s", err, state.Id))
+			return
+		}
+
+		// The configured definition is kept when the dashboard still has the same widgets and layouts.
+		definition := newDashboardDefinitionValue(definitionJson)
+		equal, d := state.DefinitionJson.StringSemanticEquals(ctx, definition)
+		diags.Append(d...)
+		if !equal {
+			state.DefinitionJson = definition
+		}
+		return
+	}
+
 	var stateWidgets []dashboardWidgetModel
 	d := state.Widgets.ElementsAs(ctx, &stateWidgets, false)
 	diags.Append(d...)
+	template := dashboardTemplateOf(ctx, state, diags)
 	if diags.HasError() {
 		return
 	}
 
+	// Widgets that were removed outside terraform (e.g., in the UI) are removed from the state.
+	stateWidgets = slices.DeleteFunc(stateWidgets, func(stateW dashboardWidgetModel) bool {
+		return !slices.ContainsFunc(dashboard.Widgets, func(w swoClient.ReadDashboardWidget) bool {
+			return stateW.Id.Equal(types.StringValue(w.Id))
+		})
+	})
+
 	for _, w := range dashboard.Widgets {
 		lIdx := slices.IndexFunc(dashboard.Layout, func(l swoClient.ReadDashboardLayout) bool { return l.Id == w.Id })
 		if lIdx <= -1 {
@@ -206,8 +584,17 @@ func setDashboardValuesFromRead(ctx context.Context, dashboard *swoClient.ReadDa
 				stateW.Width = types.Int64Value(int64(layout.Width))
 				stateW.Height = types.Int64Value(int64(layout.Height))
 
-				var stateProps any
-				err = json.Unmarshal([]byte(stateW.Properties.ValueString()), &stateProps)
+				// Typed widgets are aligned with the server values, raw properties are compared below.
+				if stateW.typedWidgetType() != "" {
+					stateW.setTypedWidget(ctx, w.Properties, diags)
+					if diags.HasError() {
+						return
+					}
+					break
+				}
+
+				// The server has the properties with the variable references replaced.
+				stateProps, err := template.renderedProperties(stateW.Properties.ValueString())
 				if err != nil {
 					diags.AddError("swo provider error",
 						fmt.Sprintf("error updating local state for dashboard: %s, id: %s", newWidgetPropertiesError(err.Error(), w.Id), state.Id))
@@ -229,13 +616,17 @@ func setDashboardValuesFromRead(ctx context.Context, dashboard *swoClient.ReadDa
 		// state with the server. This can happen if a dashboard is modified outside terraform (e.g., in the UI).
 		if !isInState {
 			stateWidgets = append(stateWidgets, dashboardWidgetModel{
-				Id:         types.StringValue(w.Id),
-				Type:       types.StringValue(w.Type),
-				X:          types.Int64Value(int64(layout.X)),
-				Y:          types.Int64Value(int64(layout.Y)),
-				Width:      types.Int64Value(int64(layout.Width)),
-				Height:     types.Int64Value(int64(layout.Height)),
-				Properties: types.StringValue(string(props)),
+				Id:           types.StringValue(w.Id),
+				Key:          types.StringNull(),
+				Type:         types.StringValue(w.Type),
+				X:            types.Int64Value(int64(layout.X)),
+				Y:            types.Int64Value(int64(layout.Y)),
+				Width:        types.Int64Value(int64(layout.Width)),
+				Height:       types.Int64Value(int64(layout.Height)),
+				Properties:   types.StringValue(string(props)),
+				Kpi:          types.ObjectNull(KpiWidgetAttributeTypes()),
+				TimeSeries:   types.ObjectNull(TimeSeriesWidgetAttributeTypes()),
+				Proportional: types.ObjectNull(ProportionalWidgetAttributeTypes()),
 			})
 		}
 	}
@@ -255,41 +646,54 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	tfVersion := tfPlan.Version.ValueInt64Pointer()
+	widgets, layouts := widgetsFromPlan(ctx, &tfPlan, map[string]string{}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard := r.createDashboard(ctx, &tfPlan, widgets, layouts, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createThenConfirm(ctx, req, resp, dashboard.Id, func() {
+		setDashboardValuesFromCreate(ctx, dashboard, &tfPlan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &tfPlan)...)
+		setWidgetIds(ctx, resp.Private, nil, widgetIdsByKey(ctx, tfPlan.Widgets, &resp.Diagnostics), &resp.Diagnostics)
+	})
+}
+
+// createDashboard creates the dashboard of the plan.
+func (r *dashboardResource) createDashboard(ctx context.Context, plan *dashboardResourceModel, widgets []swoClient.WidgetInput, layouts []swoClient.LayoutInput, diags *diag.Diagnostics) *swoClient.CreateDashboardResult {
+	tfVersion := plan.Version.ValueInt64Pointer()
 	var convertedTfVersion *int = nil
 	if tfVersion != nil {
 		temp := int(*tfVersion)
 		convertedTfVersion = &temp
 	}
-	widgets, layouts := widgetsFromPlan(ctx, tfPlan, &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
-		return
-	}
 
 	// Create the dashboard...
 	dashboard, err := r.client.
 		DashboardsService().
 		Create(ctx, swoClient.CreateDashboardInput{
-			Name:       tfPlan.Name.ValueString(),
-			CategoryId: tfPlan.CategoryId.ValueStringPointer(),
-			IsPrivate:  tfPlan.IsPrivate.ValueBoolPointer(),
+			Name:       plan.Name.ValueString(),
+			CategoryId: plan.CategoryId.ValueStringPointer(),
+			IsPrivate:  plan.IsPrivate.ValueBoolPointer(),
 			Widgets:    widgets,
 			Layout:     layouts,
 			Version:    convertedTfVersion,
 		})
 
 	if err != nil {
-		resp.Diagnostics.AddError("swo provider error",
-			fmt.Sprintf("create dashboard error: %s, name: %s", err, tfPlan.Name))
-		return
+		diags.AddError("swo provider error",
+			fmt.Sprintf("create dashboard error: %s, name: %s", err, plan.Name))
+		return nil
 	}
-
-	setDashboardValuesFromCreate(ctx, dashboard, &tfPlan, &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &tfPlan)...)
+	return dashboard
 }
 
 func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -317,6 +721,20 @@ func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &tfState)...)
+
+	// Forget the ids of the widgets that were removed outside terraform.
+	widgetIds := getWidgetIds(ctx, req.Private, &resp.Diagnostics)
+	currentIds := map[string]string{}
+	for key, id := range widgetIds {
+		if slices.ContainsFunc(dashboard.Widgets, func(w swoClient.ReadDashboardWidget) bool { return w.Id == id }) {
+			currentIds[key] = id
+		}
+	}
+	if len(currentIds) != len(widgetIds) {
+		setWidgetIds(ctx, resp.Private, widgetIds, currentIds, &resp.Diagnostics)
+	}
+
+	r.retryDeleteReplacedDashboard(ctx, req.Private, resp.Private, &resp.Diagnostics)
 }
 
 func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -336,11 +754,30 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		temp := int(*tfVersion)
 		convertedTfVersion = &temp
 	}
-	widgets, layouts := widgetsFromPlan(ctx, plan, &resp.Diagnostics)
+	widgetIds := getWidgetIds(ctx, req.Private, &resp.Diagnostics)
+	reuseStateWidgetIds(ctx, &plan, state, &resp.Diagnostics)
+	widgets, layouts := widgetsFromPlan(ctx, &plan, widgetIds, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The API can't change the privacy of a dashboard, so a new dashboard replaces the old one.
+	if plan.IsPrivate.ValueBool() != state.IsPrivate.ValueBool() {
+		dashboard := r.createDashboard(ctx, &plan, widgets, layouts, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		setDashboardValuesFromCreate(ctx, dashboard, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		setWidgetIds(ctx, resp.Private, widgetIds, widgetIdsByKey(ctx, plan.Widgets, &resp.Diagnostics), &resp.Diagnostics)
+		r.deleteReplacedDashboard(ctx, id, resp.Private, &resp.Diagnostics)
+		return
+	}
+
 	// Update the dashboard...
 	dashboard, err := r.client.DashboardsService().Update(ctx,
 		swoClient.UpdateDashboardInput{
@@ -374,6 +811,7 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 
 	// Save to Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	setWidgetIds(ctx, resp.Private, widgetIds, widgetIdsByKey(ctx, plan.Widgets, &resp.Diagnostics), &resp.Diagnostics)
 }
 
 func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -385,6 +823,21 @@ func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	id := state.Id.ValueString()
 
+	// A dashboard that was replaced after a privacy change and couldn't be deleted yet is deleted first, so it
+	// isn't left behind when the dashboard is destroyed before the next refresh.
+	replacedId := getReplacedDashboardId(ctx, req.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if replacedId != "" {
+		err := r.client.DashboardsService().Delete(ctx, replacedId)
+		if err != nil && !errors.Is(err, swoClient.ErrNotFound) {
+			resp.Diagnostics.AddError("swo provider error",
+				fmt.Sprintf("delete replaced dashboard error: %s, id: %s", err, replacedId))
+			return
+		}
+	}
+
 	// Delete the dashboard...
 	err := r.client.DashboardsService().Delete(ctx, id)
 
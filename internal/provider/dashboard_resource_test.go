@@ -145,6 +145,130 @@ func TestAccDashboardVersion2Resource(t *testing.T) {
 	})
 }
 
+func TestAccDashboardTypedWidgetsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDashboardTypedWidgetsResourceConfig("test-acc typed widgets [CREATE_TEST]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("swo_dashboard.test", "id"),
+					resource.TestCheckResourceAttr("swo_dashboard.test", "widgets.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("swo_dashboard.test", "widgets.*", map[string]string{
+						"type":                          "Kpi",
+						"kpi.title":                     "Kpi Widget",
+						"kpi.include_percentage_change": "true",
+						"kpi.series.0.metric":           "synthetics.https.response.time",
+						"kpi.series.0.aggregation":      "AVG",
+						"kpi.series.0.limit":            "50",
+						"kpi.thresholds.0.color":        "#e23b3b",
+						"time_series.%":                 "0",
+						"proportional.%":                "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("swo_dashboard.test", "widgets.*", map[string]string{
+						"type":                            "TimeSeries",
+						"time_series.chart_type":          "LineChart",
+						"time_series.show_legend":         "true",
+						"time_series.series.#":            "2",
+						"time_series.series.0.group_by.0": "probe.region",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("swo_dashboard.test", "widgets.*", map[string]string{
+						"type":                        "Proportional",
+						"proportional.chart_type":     "HorizontalBar",
+						"proportional.series.0.limit": "10",
+					}),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDashboardTypedWidgetsResourceConfig("test-acc typed widgets [UPDATE_TEST]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("swo_dashboard.test", "name", "test-acc typed widgets [UPDATE_TEST]"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDashboardTypedWidgetsResourceConfig(name string) string {
+	return providerConfig() + fmt.Sprintf(`
+	resource "swo_dashboard" "test" {
+		name = %[1]q
+		is_private = false
+		widgets = [
+			{
+				type = "Kpi"
+				x = 0
+				y = 0
+				width = 4
+				height = 2
+				kpi = {
+					title = "Kpi Widget"
+					unit = "ms"
+					thresholds = [
+						{
+							value = 500
+							color = "#e23b3b"
+						}
+					]
+					series = [
+						{
+							metric = "synthetics.https.response.time"
+							unit = "ms"
+						}
+					]
+				}
+			},
+			{
+				type = "TimeSeries"
+				x = 4
+				y = 0
+				width = 4
+				height = 2
+				time_series = {
+					title = "TimeSeries Widget"
+					unit = "ms"
+					series = [
+						{
+							metric = "synthetics.https.response.time"
+							group_by = ["probe.region"]
+							unit = "ms"
+						},
+						{
+							metric = "synthetics.error_rate"
+							group_by = ["probe.region"]
+							unit = "%%"
+							color = "#e23b3b"
+						}
+					]
+				}
+			},
+			{
+				type = "Proportional"
+				x = 0
+				y = 2
+				width = 8
+				height = 2
+				proportional = {
+					title = "Proportional Widget"
+					series = [
+						{
+							metric = "synthetics.http.response.time"
+							group_by = ["synthetics.target"]
+							limit = 10
+							limit_ascending = true
+						}
+					]
+				}
+			}
+		]
+	}`, name)
+}
+
 func testAccDashboardResourceConfig(name string) string {
 	return providerConfig() + fmt.Sprintf(`
 	resource "swo_dashboard" "test" {
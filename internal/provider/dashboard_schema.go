@@ -3,8 +3,13 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,39 +21,346 @@ import (
 
 // The main Dashboard Resource model that is derived from the schema.
 type dashboardResourceModel struct {
-	Id         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	IsPrivate  types.Bool   `tfsdk:"is_private"`
-	CategoryId types.String `tfsdk:"category_id"`
-	Widgets    types.Set    `tfsdk:"widgets"`
-	Version    types.Int64  `tfsdk:"version"`
+	Id               types.String             `tfsdk:"id"`
+	Name             types.String             `tfsdk:"name"`
+	IsPrivate        types.Bool               `tfsdk:"is_private"`
+	OwnerId          types.String             `tfsdk:"owner_id"`
+	CategoryId       types.String             `tfsdk:"category_id"`
+	Layout           types.String             `tfsdk:"layout"`
+	Widgets          types.Set                `tfsdk:"widgets"`
+	DefinitionJson   dashboardDefinitionValue `tfsdk:"definition_json"`
+	Variables        types.List               `tfsdk:"variables"`      //dashboardVariableModel
+	GlobalFilters    types.List               `tfsdk:"global_filters"` //dashboardGlobalFilterModel
+	MetricValidation types.String             `tfsdk:"metric_validation"`
+	Version          types.Int64              `tfsdk:"version"`
 }
 
 type dashboardWidgetModel struct {
-	Id         types.String `tfsdk:"id"`
-	Type       types.String `tfsdk:"type"`
-	X          types.Int64  `tfsdk:"x"`
-	Y          types.Int64  `tfsdk:"y"`
-	Width      types.Int64  `tfsdk:"width"`
-	Height     types.Int64  `tfsdk:"height"`
-	Properties types.String `tfsdk:"properties"`
+	Id           types.String `tfsdk:"id"`
+	Key          types.String `tfsdk:"key"`
+	Type         types.String `tfsdk:"type"`
+	X            types.Int64  `tfsdk:"x"`
+	Y            types.Int64  `tfsdk:"y"`
+	Width        types.Int64  `tfsdk:"width"`
+	Height       types.Int64  `tfsdk:"height"`
+	Row          types.Int64  `tfsdk:"row"`
+	Span         types.Int64  `tfsdk:"span"`
+	Properties   types.String `tfsdk:"properties"`
+	Kpi          types.Object `tfsdk:"kpi"`
+	TimeSeries   types.Object `tfsdk:"time_series"`
+	Proportional types.Object `tfsdk:"proportional"`
+}
+
+type dashboardSeriesModel struct {
+	Metric         types.String `tfsdk:"metric"`
+	Aggregation    types.String `tfsdk:"aggregation"`
+	GroupBy        types.List   `tfsdk:"group_by"`
+	Limit          types.Int64  `tfsdk:"limit"`
+	LimitAscending types.Bool   `tfsdk:"limit_ascending"`
+	Unit           types.String `tfsdk:"unit"`
+	Precision      types.Int64  `tfsdk:"precision"`
+	Color          types.String `tfsdk:"color"`
+}
+
+type dashboardThresholdModel struct {
+	Value types.Float64 `tfsdk:"value"`
+	Color types.String  `tfsdk:"color"`
+}
+
+type dashboardKpiWidgetModel struct {
+	Title                   types.String              `tfsdk:"title"`
+	Subtitle                types.String              `tfsdk:"subtitle"`
+	Unit                    types.String              `tfsdk:"unit"`
+	LinkUrl                 types.String              `tfsdk:"link_url"`
+	LinkLabel               types.String              `tfsdk:"link_label"`
+	IsHigherBetter          types.Bool                `tfsdk:"is_higher_better"`
+	IncludePercentageChange types.Bool                `tfsdk:"include_percentage_change"`
+	Thresholds              []dashboardThresholdModel `tfsdk:"thresholds"`
+	Series                  []dashboardSeriesModel    `tfsdk:"series"`
+}
+
+type dashboardTimeSeriesWidgetModel struct {
+	Title      types.String           `tfsdk:"title"`
+	Subtitle   types.String           `tfsdk:"subtitle"`
+	ChartType  types.String           `tfsdk:"chart_type"`
+	YAxisLabel types.String           `tfsdk:"y_axis_label"`
+	YAxisMax   types.String           `tfsdk:"y_axis_max"`
+	ShowLegend types.Bool             `tfsdk:"show_legend"`
+	Unit       types.String           `tfsdk:"unit"`
+	Precision  types.Int64            `tfsdk:"precision"`
+	Series     []dashboardSeriesModel `tfsdk:"series"`
+}
+
+type dashboardProportionalWidgetModel struct {
+	Title      types.String           `tfsdk:"title"`
+	Subtitle   types.String           `tfsdk:"subtitle"`
+	ChartType  types.String           `tfsdk:"chart_type"`
+	ShowLegend types.Bool             `tfsdk:"show_legend"`
+	Unit       types.String           `tfsdk:"unit"`
+	Series     []dashboardSeriesModel `tfsdk:"series"`
 }
 
 func WidgetAttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"id":         types.StringType,
-		"type":       types.StringType,
-		"x":          types.Int64Type,
-		"y":          types.Int64Type,
-		"width":      types.Int64Type,
-		"height":     types.Int64Type,
-		"properties": types.StringType,
+		"id":           types.StringType,
+		"key":          types.StringType,
+		"type":         types.StringType,
+		"x":            types.Int64Type,
+		"y":            types.Int64Type,
+		"width":        types.Int64Type,
+		"height":       types.Int64Type,
+		"row":          types.Int64Type,
+		"span":         types.Int64Type,
+		"properties":   types.StringType,
+		"kpi":          types.ObjectType{AttrTypes: KpiWidgetAttributeTypes()},
+		"time_series":  types.ObjectType{AttrTypes: TimeSeriesWidgetAttributeTypes()},
+		"proportional": types.ObjectType{AttrTypes: ProportionalWidgetAttributeTypes()},
+	}
+}
+
+func SeriesAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"metric":          types.StringType,
+		"aggregation":     types.StringType,
+		"group_by":        types.ListType{ElemType: types.StringType},
+		"limit":           types.Int64Type,
+		"limit_ascending": types.BoolType,
+		"unit":            types.StringType,
+		"precision":       types.Int64Type,
+		"color":           types.StringType,
+	}
+}
+
+func KpiWidgetAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"title":                     types.StringType,
+		"subtitle":                  types.StringType,
+		"unit":                      types.StringType,
+		"link_url":                  types.StringType,
+		"link_label":                types.StringType,
+		"is_higher_better":          types.BoolType,
+		"include_percentage_change": types.BoolType,
+		"thresholds": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"value": types.Float64Type,
+			"color": types.StringType,
+		}}},
+		"series": types.ListType{ElemType: types.ObjectType{AttrTypes: SeriesAttributeTypes()}},
+	}
+}
+
+func TimeSeriesWidgetAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"title":        types.StringType,
+		"subtitle":     types.StringType,
+		"chart_type":   types.StringType,
+		"y_axis_label": types.StringType,
+		"y_axis_max":   types.StringType,
+		"show_legend":  types.BoolType,
+		"unit":         types.StringType,
+		"precision":    types.Int64Type,
+		"series":       types.ListType{ElemType: types.ObjectType{AttrTypes: SeriesAttributeTypes()}},
+	}
+}
+
+func ProportionalWidgetAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"title":       types.StringType,
+		"subtitle":    types.StringType,
+		"chart_type":  types.StringType,
+		"show_legend": types.BoolType,
+		"unit":        types.StringType,
+		"series":      types.ListType{ElemType: types.ObjectType{AttrTypes: SeriesAttributeTypes()}},
+	}
+}
+
+// widgetTitleAttributes returns the attributes shared by all typed widgets.
+func widgetTitleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"title": schema.StringAttribute{
+			Description: "The title of the widget.",
+			Optional:    true,
+		},
+		"subtitle": schema.StringAttribute{
+			Description: "The subtitle of the widget.",
+			Optional:    true,
+		},
+	}
+}
+
+// widgetSeriesAttribute returns the schema of the metric queries displayed by a typed widget.
+func widgetSeriesAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "The metric queries displayed by the widget.",
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"metric": schema.StringAttribute{
+					Description: "The name of the metric.",
+					Required:    true,
+				},
+				"aggregation": schema.StringAttribute{
+					Description: "The aggregation function applied to the metric. Default is `AVG`. " +
+						"Valid values are [`AVG`|`COUNT`|`LAST`|`MAX`|`MIN`|`SUM`].",
+					Optional: true,
+					Computed: true,
+					Validators: []validator.String{
+						validators.OneOf("AVG", "COUNT", "LAST", "MAX", "MIN", "SUM"),
+					},
+				},
+				"group_by": schema.ListAttribute{
+					Description: "The metric attributes the query is grouped by.",
+					ElementType: types.StringType,
+					Optional:    true,
+					Computed:    true,
+				},
+				"limit": schema.Int64Attribute{
+					Description: "The maximum number of groups displayed. Default is `50`.",
+					Optional:    true,
+					Computed:    true,
+				},
+				"limit_ascending": schema.BoolAttribute{
+					Description: "True if the groups with the lowest values are displayed. Default is `false`.",
+					Optional:    true,
+					Computed:    true,
+				},
+				"unit": schema.StringAttribute{
+					Description: "The unit of the metric values, e.g. `ms` or `%`.",
+					Optional:    true,
+				},
+				"precision": schema.Int64Attribute{
+					Description: "The number of significant digits displayed. Default is `3`.",
+					Optional:    true,
+					Computed:    true,
+				},
+				"color": schema.StringAttribute{
+					Description: "The color of the series, e.g. `#1f77b4`.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func kpiWidgetAttribute() schema.SingleNestedAttribute {
+	attributes := widgetTitleAttributes()
+	attributes["unit"] = schema.StringAttribute{
+		Description: "The unit displayed next to the value.",
+		Optional:    true,
+	}
+	attributes["link_url"] = schema.StringAttribute{
+		Description: "A link displayed on the widget.",
+		Optional:    true,
+	}
+	attributes["link_label"] = schema.StringAttribute{
+		Description: "The label of the link.",
+		Optional:    true,
+	}
+	attributes["is_higher_better"] = schema.BoolAttribute{
+		Description: "True if an increase of the value is an improvement. Default is `false`.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["include_percentage_change"] = schema.BoolAttribute{
+		Description: "True if the change of the value in percent is displayed. Default is `true`.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["thresholds"] = schema.ListNestedAttribute{
+		Description: "Colors the value once it reaches a threshold.",
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"value": schema.Float64Attribute{
+					Description: "The threshold value.",
+					Required:    true,
+				},
+				"color": schema.StringAttribute{
+					Description: "The color of the value once it reaches the threshold, e.g. `#e23b3b`.",
+					Required:    true,
+				},
+			},
+		},
+	}
+	attributes["series"] = widgetSeriesAttribute()
+
+	return schema.SingleNestedAttribute{
+		Description: "The configuration of a `Kpi` widget.",
+		Optional:    true,
+		Attributes:  attributes,
+	}
+}
+
+func timeSeriesWidgetAttribute() schema.SingleNestedAttribute {
+	attributes := widgetTitleAttributes()
+	attributes["chart_type"] = schema.StringAttribute{
+		Description: "The type of the chart. Default is `LineChart`.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["y_axis_label"] = schema.StringAttribute{
+		Description: "The label of the Y axis.",
+		Optional:    true,
+	}
+	attributes["y_axis_max"] = schema.StringAttribute{
+		Description: "The maximum of the Y axis. Default is `auto`.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["show_legend"] = schema.BoolAttribute{
+		Description: "True if the legend is displayed. Default is `true`.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["unit"] = schema.StringAttribute{
+		Description: "The unit of the Y axis.",
+		Optional:    true,
+	}
+	attributes["precision"] = schema.Int64Attribute{
+		Description: "The number of significant digits displayed on the Y axis. Default is `3`.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["series"] = widgetSeriesAttribute()
+
+	return schema.SingleNestedAttribute{
+		Description: "The configuration of a `TimeSeries` widget.",
+		Optional:    true,
+		Attributes:  attributes,
+	}
+}
+
+func proportionalWidgetAttribute() schema.SingleNestedAttribute {
+	attributes := widgetTitleAttributes()
+	attributes["chart_type"] = schema.StringAttribute{
+		Description: "The type of the chart. Default is `HorizontalBar`.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["show_legend"] = schema.BoolAttribute{
+		Description: "True if the legend is displayed. Default is `false`.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["unit"] = schema.StringAttribute{
+		Description: "The unit of the values.",
+		Optional:    true,
+	}
+	attributes["series"] = widgetSeriesAttribute()
+
+	return schema.SingleNestedAttribute{
+		Description: "The configuration of a `Proportional` widget.",
+		Optional:    true,
+		Attributes:  attributes,
 	}
 }
 
 func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A terraform resource for managing dashboards.",
+		Version:     dashboardSchemaVersion,
 		Attributes: map[string]schema.Attribute{
 			"id": resourceIdAttribute(),
 			"name": schema.StringAttribute{
@@ -56,16 +368,34 @@ func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 			},
 			"is_private": schema.BoolAttribute{
-				Description: "True if the dashboard is restricted to the owner",
-				Optional:    true,
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
+				Description: "True if the dashboard is restricted to the owner. The SWO API can't change the privacy " +
+					"of a dashboard, so changing it creates a new dashboard with the same name and widgets and " +
+					"deletes the old one. The id and the URL of the dashboard change. An old dashboard that can't be " +
+					"deleted is deleted on the next refresh, or when the dashboard is destroyed.",
+				Optional: true,
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "The id of the user that owns the dashboard. The SWO API has no dashboard sharing, " +
+					"a private dashboard is only visible to its owner.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"category_id": schema.StringAttribute{
-				Description: "The category that this dashboard is assigned to.",
+				Description: "The id of the category that this dashboard is assigned to, e.g. `swo_dashboard_category.payments.id`.",
 				Optional:    true,
 			},
+			"layout": schema.StringAttribute{
+				Description: "How the widgets are placed on the dashboard. With `manual`, each widget sets `x`, `y`, " +
+					"`width` and `height`. With `auto`, the provider packs the widgets into the grid using the `row` " +
+					"and `span` of each widget, and the widgets of a row are placed from left to right in the order " +
+					"of their `key`. Default is `manual`. Valid values are [`auto`|`manual`].",
+				Optional: true,
+				Validators: []validator.String{
+					validators.OneOf(dashboardLayoutAuto, dashboardLayoutManual),
+				},
+			},
 			"widgets": schema.SetNestedAttribute{
 				Description: "The widgets that are placed on the dashboard.",
 				Optional:    true,
@@ -75,39 +405,156 @@ func (r *dashboardResource) Schema(_ context.Context, _ resource.SchemaRequest,
 							Description: "The computed id of the widget.",
 							Computed:    true,
 						},
+						"key": schema.StringAttribute{
+							Description: "A unique key that identifies the widget across applies. The id of a widget " +
+								"with a key is kept when the widget is moved or changed.",
+							Optional: true,
+						},
 						"type": schema.StringAttribute{
-							Description: "The type of the widget. Valid values are [`Kpi`|`Proportional`|`TimeSeries`].",
-							Required:    true,
+							Description: "The type of the widget, e.g. `Kpi`, `TimeSeries`, `Proportional`, `Markdown`, " +
+								"`Logs`, `Table`, `Gauge`, `AlertList` or `Heatmap`. Any type of the dashboards API is " +
+								"accepted. Widgets without a typed block are configured with `properties`.",
+							Required: true,
 							Validators: []validator.String{
-								validators.OneOf("Kpi", "Proportional", "TimeSeries"),
+								stringvalidator.LengthAtLeast(1),
 							},
 						},
 						"x": schema.Int64Attribute{
-							Description: "The X position of the widget.",
-							Required:    true,
+							Description: "The X position of the widget, from 0 to 11. Required unless `layout` is `auto`.",
+							Optional:    true,
+							Computed:    true,
 						},
 						"y": schema.Int64Attribute{
-							Description: "The Y position of the widget.",
-							Required:    true,
+							Description: "The Y position of the widget. Required unless `layout` is `auto`.",
+							Optional:    true,
+							Computed:    true,
 						},
 						"width": schema.Int64Attribute{
-							Description: "The width of the widget.",
-							Required:    true,
+							Description: "The width of the widget in columns, from 1 to 12. Required unless `layout` is `auto`.",
+							Optional:    true,
+							Computed:    true,
 						},
 						"height": schema.Int64Attribute{
-							Description: "The height of the widget.",
-							Required:    true,
+							Description: "The height of the widget. Required unless `layout` is `auto`, where the default is " +
+								"`2`, or `6` for version 2 dashboards.",
+							Optional: true,
+							Computed: true,
+						},
+						"row": schema.Int64Attribute{
+							Description: "The row of the widget when `layout` is `auto`. Rows are placed from top to bottom.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"span": schema.Int64Attribute{
+							Description: "The number of columns the widget spans when `layout` is `auto`. By default the " +
+								"columns left in the row are shared by the widgets without a span.",
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, dashboardColumns),
+							},
 						},
 						"properties": schema.StringAttribute{
-							Description: "A JSON encoded string that defines the widget configuration.",
-							Required:    true,
+							Description: "A JSON encoded string that defines the widget configuration. " +
+								"Exactly one of `properties`, `kpi`, `time_series` or `proportional` must be set.",
+							Optional: true,
 							PlanModifiers: []planmodifier.String{
 								useStandarizedJson(),
 							},
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("kpi"),
+									path.MatchRelative().AtParent().AtName("time_series"),
+									path.MatchRelative().AtParent().AtName("proportional"),
+								),
+							},
+						},
+						"kpi":          kpiWidgetAttribute(),
+						"time_series":  timeSeriesWidgetAttribute(),
+						"proportional": proportionalWidgetAttribute(),
+					},
+				},
+			},
+			"definition_json": schema.StringAttribute{
+				Description: "The widgets and layout of the dashboard in the JSON format of the dashboard export " +
+					"in the SolarWinds Observability UI. Only the `widgets` and `layout` fields of the export are " +
+					"used, and every widget needs an `id` and a layout. The definition that is read back from the " +
+					"dashboard isn't shown as a change when it only differs in formatting, field order or widget " +
+					"order. The variable and global filter references aren't replaced in a definition. Conflicts " +
+					"with `widgets`, `layout`, `variables` and `global_filters`.",
+				Optional:   true,
+				CustomType: dashboardDefinitionType{},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("widgets"),
+						path.MatchRoot("layout"),
+						path.MatchRoot("variables"),
+						path.MatchRoot("global_filters"),
+					),
+				},
+			},
+			"variables": schema.ListNestedAttribute{
+				Description: "Variables of the dashboard. The `properties` of the widgets reference a variable with " +
+					"`{{var.<name>}}`, which is replaced by its default values separated by commas, and with " +
+					"`{{var.<name>.tag_key}}` and `{{var.<name>.entity_type}}`. The references are checked at plan " +
+					"time and replaced by the provider, as the dashboards API has no dashboard variables.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the variable. Only letters, digits and underscores are allowed.",
+							Required:    true,
+						},
+						"entity_type": schema.StringAttribute{
+							Description: "The entity type that the tag belongs to, e.g. `Service`.",
+							Optional:    true,
+						},
+						"tag_key": schema.StringAttribute{
+							Description: "The tag key that the variable filters on, e.g. `service.name`.",
+							Required:    true,
+						},
+						"default_values": schema.ListAttribute{
+							Description: "The values of the variable.",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"global_filters": schema.ListNestedAttribute{
+				Description: "Filters that apply a variable to the widgets. The `properties` of the widgets reference " +
+					"a global filter with `{{filter.<name>}}`, which is replaced by `<tag_key>:<value>` for a " +
+					"variable with one value, or `<tag_key>:(<value> OR <value>)` for more values.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the global filter. Only letters, digits and underscores are allowed.",
+							Required:    true,
+						},
+						"variable": schema.StringAttribute{
+							Description: "The name of the variable that the filter applies.",
+							Required:    true,
 						},
 					},
 				},
 			},
+			"metric_validation": schema.StringAttribute{
+				Description: "How unknown metrics in the widgets are reported at plan time. The metrics of the " +
+					"widgets are checked against the metrics API. Composite metrics that don't exist yet are skipped, as " +
+					"they may be created by the same apply, and the metrics in query strings aren't checked. " +
+					"Default is `warning`. Valid values are [`warning`|`error`|`off`].",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(metricValidationWarning),
+				Validators: []validator.String{
+					validators.OneOf(metricValidationWarning, metricValidationError, metricValidationOff),
+				},
+			},
 			"version": schema.Int64Attribute{
 				Description: "Default version is null. " +
 					"Version 2 triples the granularity of widget heights. " +
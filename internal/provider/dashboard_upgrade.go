@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithUpgradeState = &dashboardResource{}
+
+// The schema version of the dashboard resource. Version 1 added the typed widgets, the layouts, the dashboard
+// definition, the variables and the metric validation.
+const dashboardSchemaVersion = 1
+
+// The dashboard resource model of schema version 0.
+type dashboardResourceModelV0 struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	IsPrivate  types.Bool   `tfsdk:"is_private"`
+	CategoryId types.String `tfsdk:"category_id"`
+	Widgets    types.Set    `tfsdk:"widgets"`
+	Version    types.Int64  `tfsdk:"version"`
+}
+
+type dashboardWidgetModelV0 struct {
+	Id         types.String `tfsdk:"id"`
+	Type       types.String `tfsdk:"type"`
+	X          types.Int64  `tfsdk:"x"`
+	Y          types.Int64  `tfsdk:"y"`
+	Width      types.Int64  `tfsdk:"width"`
+	Height     types.Int64  `tfsdk:"height"`
+	Properties types.String `tfsdk:"properties"`
+}
+
+// dashboardSchemaV0 returns the attributes of schema version 0, which are used to decode the prior state.
+func dashboardSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": resourceIdAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"is_private": schema.BoolAttribute{
+				Optional: true,
+			},
+			"category_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"widgets": schema.SetNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":         schema.StringAttribute{Computed: true},
+						"type":       schema.StringAttribute{Required: true},
+						"x":          schema.Int64Attribute{Required: true},
+						"y":          schema.Int64Attribute{Required: true},
+						"width":      schema.Int64Attribute{Required: true},
+						"height":     schema.Int64Attribute{Required: true},
+						"properties": schema.StringAttribute{Required: true},
+					},
+				},
+			},
+			"version": schema.Int64Attribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *dashboardResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   dashboardSchemaV0(),
+			StateUpgrader: upgradeDashboardStateV0,
+		},
+	}
+}
+
+// upgradeDashboardStateV0 sets the attributes that were added by schema version 1. The widgets keep their heights
+// and the dashboard keeps its version, as the state mirrors the dashboard that is stored by the dashboards API.
+func upgradeDashboardStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior dashboardResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorWidgets []dashboardWidgetModelV0
+	if !prior.Widgets.IsNull() {
+		resp.Diagnostics.Append(prior.Widgets.ElementsAs(ctx, &priorWidgets, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	widgets := make([]dashboardWidgetModel, 0, len(priorWidgets))
+	for _, w := range priorWidgets {
+		widgets = append(widgets, dashboardWidgetModel{
+			Id:           w.Id,
+			Key:          types.StringNull(),
+			Type:         w.Type,
+			X:            w.X,
+			Y:            w.Y,
+			Width:        w.Width,
+			Height:       w.Height,
+			Row:          types.Int64Null(),
+			Span:         types.Int64Null(),
+			Properties:   w.Properties,
+			Kpi:          types.ObjectNull(KpiWidgetAttributeTypes()),
+			TimeSeries:   types.ObjectNull(TimeSeriesWidgetAttributeTypes()),
+			Proportional: types.ObjectNull(ProportionalWidgetAttributeTypes()),
+		})
+	}
+	tfWidgets := types.SetNull(types.ObjectType{AttrTypes: WidgetAttributeTypes()})
+	if !prior.Widgets.IsNull() {
+		var d diag.Diagnostics
+		tfWidgets, d = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: WidgetAttributeTypes()}, widgets)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state := dashboardResourceModel{
+		Id:             prior.Id,
+		Name:           prior.Name,
+		IsPrivate:      prior.IsPrivate,
+		OwnerId:        types.StringNull(),
+		CategoryId:     prior.CategoryId,
+		Layout:         types.StringNull(),
+		Widgets:        tfWidgets,
+		DefinitionJson: newDashboardDefinitionNull(),
+		Variables:      types.ListNull(types.ObjectType{AttrTypes: VariableAttributeTypes()}),
+		GlobalFilters:  types.ListNull(types.ObjectType{AttrTypes: GlobalFilterAttributeTypes()}),
+		// Dashboards created before 'metric_validation' was added use its default.
+		MetricValidation: types.StringValue(metricValidationWarning),
+		Version:          prior.Version,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDashboardResourceUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	_, client := newFakeDashboardServer(t)
+	r := &dashboardResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	tfSchema := schemaResp.Schema
+	emptyState := func() tfsdk.State {
+		return tfsdk.State{Schema: tfSchema, Raw: tftypes.NewValue(tfSchema.Type().TerraformType(ctx), nil)}
+	}
+
+	widget := testRawWidget("Markdown", 0, `{"text":"# Runbook"}`)
+	createResp := resource.CreateResponse{State: emptyState()}
+	r.Create(ctx, resource.CreateRequest{Plan: testDashboardPlan(t, tfSchema, []dashboardWidgetModel{widget})}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned errors: %v", createResp.Diagnostics)
+	}
+	var created dashboardResourceModel
+	createResp.State.Get(ctx, &created)
+
+	// The state of the dashboard as it was written by schema version 0.
+	widgetIds := testWidgetIdsByType(t, createResp.State)
+	rawState := tfprotov6.RawState{JSON: []byte(`{
+		"id": "` + created.Id.ValueString() + `",
+		"name": "runbook",
+		"is_private": false,
+		"category_id": null,
+		"version": null,
+		"widgets": [
+			{"id": "` + widgetIds["Markdown"] + `", "type": "Markdown", "x": 0, "y": 0, "width": 4, "height": 2,
+			 "properties": "{\"text\":\"# Runbook\"}"}
+		]
+	}`)}
+	upgrader := r.UpgradeState(ctx)[0]
+	priorValue, err := rawState.UnmarshalWithOpts(upgrader.PriorSchema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{})
+	if err != nil {
+		t.Fatalf("the state of schema version 0 can't be decoded with the prior schema: %s", err)
+	}
+
+	upgradeResp := resource.UpgradeStateResponse{State: emptyState()}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{
+		State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: priorValue},
+	}, &upgradeResp)
+	if upgradeResp.Diagnostics.HasError() {
+		t.Fatalf("UpgradeState() returned errors: %v", upgradeResp.Diagnostics)
+	}
+
+	var upgraded dashboardResourceModel
+	if diags := upgradeResp.State.Get(ctx, &upgraded); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !upgraded.Version.IsNull() {
+		t.Errorf("expected the version to be kept, got %s", upgraded.Version)
+	}
+	if upgraded.MetricValidation.ValueString() != metricValidationWarning {
+		t.Errorf("expected the default metric validation, got %s", upgraded.MetricValidation)
+	}
+	var widgets []dashboardWidgetModel
+	if diags := upgraded.Widgets.ElementsAs(ctx, &widgets, false); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(widgets) != 1 || widgets[0].Height.ValueInt64() != 2 || widgets[0].Y.ValueInt64() != 0 ||
+		!widgets[0].Kpi.IsNull() || !widgets[0].Key.IsNull() {
+		t.Errorf("expected the widget to be kept as it is, got %v", widgets)
+	}
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	dashboardNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// Matches {{var.<name>}}, {{var.<name>.tag_key}}, {{var.<name>.entity_type}} and {{filter.<name>}}.
+	dashboardReferenceRegex = regexp.MustCompile(`\{\{\s*((?:var|filter)\.[A-Za-z0-9_]+(?:\.(?:tag_key|entity_type))?)\s*\}\}`)
+)
+
+type dashboardVariableModel struct {
+	Name          types.String `tfsdk:"name"`
+	EntityType    types.String `tfsdk:"entity_type"`
+	TagKey        types.String `tfsdk:"tag_key"`
+	DefaultValues []string     `tfsdk:"default_values"`
+}
+
+type dashboardGlobalFilterModel struct {
+	Name     types.String `tfsdk:"name"`
+	Variable types.String `tfsdk:"variable"`
+}
+
+func VariableAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":           types.StringType,
+		"entity_type":    types.StringType,
+		"tag_key":        types.StringType,
+		"default_values": types.ListType{ElemType: types.StringType},
+	}
+}
+
+func GlobalFilterAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":     types.StringType,
+		"variable": types.StringType,
+	}
+}
+
+// filterExpression returns the filter of the tag key of the variable for its default values.
+func (v *dashboardVariableModel) filterExpression() string {
+	if len(v.DefaultValues) == 1 {
+		return fmt.Sprintf("%s:%s", v.TagKey.ValueString(), v.DefaultValues[0])
+	}
+	return fmt.Sprintf("%s:(%s)", v.TagKey.ValueString(), strings.Join(v.DefaultValues, " OR "))
+}
+
+// dashboardTemplate holds the value of every reference that can be used in widget properties. The dashboards API
+// has no dashboard variables, so the references are replaced by the provider before the widgets are sent.
+type dashboardTemplate map[string]string
+
+func newDashboardTemplate(variables []dashboardVariableModel, filters []dashboardGlobalFilterModel) dashboardTemplate {
+	t := dashboardTemplate{}
+	variablesByName := map[string]*dashboardVariableModel{}
+	for i := range variables {
+		v := &variables[i]
+		name := "var." + v.Name.ValueString()
+		variablesByName[v.Name.ValueString()] = v
+		t[name] = strings.Join(v.DefaultValues, ",")
+		t[name+".tag_key"] = v.TagKey.ValueString()
+		t[name+".entity_type"] = v.EntityType.ValueString()
+	}
+	for _, f := range filters {
+		if v, found := variablesByName[f.Variable.ValueString()]; found {
+			t["filter."+f.Name.ValueString()] = v.filterExpression()
+		}
+	}
+	return t
+}
+
+// dashboardTemplateOf returns the template of the variables and global filters of the dashboard.
+func dashboardTemplateOf(ctx context.Context, m *dashboardResourceModel, diags *diag.Diagnostics) dashboardTemplate {
+	var variables []dashboardVariableModel
+	var filters []dashboardGlobalFilterModel
+	if !m.Variables.IsNull() {
+		diags.Append(m.Variables.ElementsAs(ctx, &variables, false)...)
+	}
+	if !m.GlobalFilters.IsNull() {
+		diags.Append(m.GlobalFilters.ElementsAs(ctx, &filters, false)...)
+	}
+	if diags.HasError() {
+		return nil
+	}
+	return newDashboardTemplate(variables, filters)
+}
+
+// render replaces the references in the strings of the JSON value with their values.
+func (t dashboardTemplate) render(value any) any {
+	if len(t) == 0 {
+		return value
+	}
+
+	switch v := value.(type) {
+	case string:
+		return dashboardReferenceRegex.ReplaceAllStringFunc(v, func(reference string) string {
+			if s, found := t[dashboardReferenceRegex.FindStringSubmatch(reference)[1]]; found {
+				return s
+			}
+			return reference
+		})
+	case map[string]any:
+		for key, element := range v {
+			v[key] = t.render(element)
+		}
+	case []any:
+		for i, element := range v {
+			v[i] = t.render(element)
+		}
+	}
+	return value
+}
+
+// unresolvedReferences returns the references in the string that aren't declared in the template.
+func (t dashboardTemplate) unresolvedReferences(s string) []string {
+	var references []string
+	for _, match := range dashboardReferenceRegex.FindAllStringSubmatch(s, -1) {
+		if _, found := t[match[1]]; !found {
+			references = append(references, match[1])
+		}
+	}
+	return references
+}
+
+// validateDashboardTemplate checks the names of the variables and global filters, and that the widget properties
+// only reference declared variables and global filters.
+func validateDashboardTemplate(variables []dashboardVariableModel, filters []dashboardGlobalFilterModel, widgets []dashboardWidgetModel, diags *diag.Diagnostics) {
+	variableNames := map[string]bool{}
+	for _, v := range variables {
+		name := v.Name.ValueString()
+		if !dashboardNameRegex.MatchString(name) {
+			diags.AddAttributeError(path.Root("variables"), "Invalid Dashboard Variable",
+				fmt.Sprintf("The variable name '%s' must start with a letter or underscore and only contain "+
+					"letters, digits and underscores.", name))
+		}
+		if variableNames[name] {
+			diags.AddAttributeError(path.Root("variables"), "Invalid Dashboard Variable",
+				fmt.Sprintf("The variable name '%s' is used by more than one variable.", name))
+		}
+		variableNames[name] = true
+	}
+
+	filterNames := map[string]bool{}
+	for _, f := range filters {
+		name := f.Name.ValueString()
+		if !dashboardNameRegex.MatchString(name) {
+			diags.AddAttributeError(path.Root("global_filters"), "Invalid Dashboard Global Filter",
+				fmt.Sprintf("The global filter name '%s' must start with a letter or underscore and only contain "+
+					"letters, digits and underscores.", name))
+		}
+		if filterNames[name] {
+			diags.AddAttributeError(path.Root("global_filters"), "Invalid Dashboard Global Filter",
+				fmt.Sprintf("The global filter name '%s' is used by more than one global filter.", name))
+		}
+		filterNames[name] = true
+
+		if !variableNames[f.Variable.ValueString()] {
+			diags.AddAttributeError(path.Root("global_filters"), "Invalid Dashboard Global Filter",
+				fmt.Sprintf("The global filter '%s' references the variable '%s', which isn't declared.",
+					name, f.Variable.ValueString()))
+		}
+	}
+
+	t := newDashboardTemplate(variables, filters)
+	for _, w := range widgets {
+		if w.typedWidgetType() != "" || w.Properties.IsNull() || w.Properties.IsUnknown() {
+			continue
+		}
+		for _, reference := range t.unresolvedReferences(w.Properties.ValueString()) {
+			diags.AddAttributeError(path.Root("widgets"), "Invalid Widget Reference",
+				fmt.Sprintf("The %s references '%s', which isn't a declared variable or global filter.",
+					w.widgetLabel(), reference))
+		}
+	}
+}
+
+// renderedProperties returns the JSON encoded properties with the references replaced.
+func (t dashboardTemplate) renderedProperties(properties string) (any, error) {
+	var props any
+	if err := json.Unmarshal([]byte(properties), &props); err != nil {
+		return nil, err
+	}
+	return t.render(props), nil
+}
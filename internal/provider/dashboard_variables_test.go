@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testDashboardVariables() ([]dashboardVariableModel, []dashboardGlobalFilterModel) {
+	variables := []dashboardVariableModel{
+		{
+			Name:          types.StringValue("service"),
+			EntityType:    types.StringValue("Service"),
+			TagKey:        types.StringValue("service.name"),
+			DefaultValues: []string{"checkout"},
+		},
+		{
+			Name:          types.StringValue("env"),
+			EntityType:    types.StringNull(),
+			TagKey:        types.StringValue("env"),
+			DefaultValues: []string{"prod", "staging"},
+		},
+	}
+	filters := []dashboardGlobalFilterModel{
+		{Name: types.StringValue("by_service"), Variable: types.StringValue("service")},
+		{Name: types.StringValue("by_env"), Variable: types.StringValue("env")},
+	}
+	return variables, filters
+}
+
+func TestDashboardTemplateRender(t *testing.T) {
+	template := newDashboardTemplate(testDashboardVariables())
+
+	got, err := template.renderedProperties(`{
+		"title": "Errors of {{var.service}}",
+		"query": "{{filter.by_service}} {{ filter.by_env }} level:error",
+		"tags": ["{{var.service.tag_key}}", "{{var.service.entity_type}}"]
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"title": "Errors of checkout",
+		"query": "service.name:checkout env:(prod OR staging) level:error",
+		"tags":  []any{"service.name", "Service"},
+	}
+	if !cmp.Equal(got, any(want)) {
+		t.Errorf("unexpected rendered properties: %s", cmp.Diff(want, got))
+	}
+}
+
+func TestValidateDashboardTemplate(t *testing.T) {
+	variables, filters := testDashboardVariables()
+	widget := testRawWidget("Logs", 0, `{"query":"{{filter.by_service}} {{var.region}}"}`)
+
+	var diags diag.Diagnostics
+	validateDashboardTemplate(variables, filters, []dashboardWidgetModel{widget}, &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Errorf("expected an error for the undeclared variable, got %v", diags)
+	}
+
+	diags = nil
+	filters = append(filters, dashboardGlobalFilterModel{Name: types.StringValue("by_env"), Variable: types.StringValue("region")})
+	validateDashboardTemplate(variables, filters, nil, &diags)
+	if diags.ErrorsCount() != 2 {
+		t.Errorf("expected errors for the duplicate filter and the undeclared variable, got %v", diags)
+	}
+}
+
+func TestDashboardVariablesFakeServer(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newFakeDashboardServer(t)
+	r := &dashboardResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	tfSchema := schemaResp.Schema
+
+	variables, filters := testDashboardVariables()
+	tfVariables, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VariableAttributeTypes()}, variables)
+	tfFilters, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: GlobalFilterAttributeTypes()}, filters)
+	diags.Append(d...)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	plan := testDashboardPlan(t, tfSchema, []dashboardWidgetModel{
+		testRawWidget("Logs", 0, `{"query":"{{filter.by_service}} level:error"}`),
+	})
+	plan.SetAttribute(ctx, path.Root("variables"), tfVariables)
+	plan.SetAttribute(ctx, path.Root("global_filters"), tfFilters)
+
+	createResp := resource.CreateResponse{
+		State: tfsdk.State{Schema: tfSchema, Raw: tftypes.NewValue(tfSchema.Type().TerraformType(ctx), nil)},
+	}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned errors: %v", createResp.Diagnostics)
+	}
+	var created dashboardResourceModel
+	createResp.State.Get(ctx, &created)
+
+	// The server gets the properties with the references replaced.
+	widget := fake.dashboard(created.Id.ValueString())["widgets"].([]any)[0].(map[string]any)
+	if query := widget["properties"].(map[string]any)["query"]; query != "service.name:checkout level:error" {
+		t.Errorf("the server got the query %q", query)
+	}
+
+	// Reading the rendered properties back keeps the references in the state.
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned errors: %v", readResp.Diagnostics)
+	}
+	var read dashboardResourceModel
+	readResp.State.Get(ctx, &read)
+	if !read.Widgets.Equal(created.Widgets) {
+		t.Errorf("widgets changed after Read:\n%s\n%s", created.Widgets, read.Widgets)
+	}
+}
@@ -0,0 +1,429 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	widgetTypeKpi          = "Kpi"
+	widgetTypeTimeSeries   = "TimeSeries"
+	widgetTypeProportional = "Proportional"
+)
+
+// The widget properties as they are stored by the dashboards API.
+type widgetSeries struct {
+	Type                string              `json:"type"`
+	Metric              string              `json:"metric"`
+	AggregationFunction string              `json:"aggregationFunction"`
+	BucketGrouping      []string            `json:"bucketGrouping"`
+	GroupBy             []string            `json:"groupBy"`
+	Limit               widgetLimit         `json:"limit"`
+	FormatOptions       widgetFormatOptions `json:"formatOptions"`
+	Color               *string             `json:"color,omitempty"`
+}
+
+type widgetLimit struct {
+	Value       int  `json:"value"`
+	IsAscending bool `json:"isAscending"`
+}
+
+type widgetFormatOptions struct {
+	Unit      *string `json:"unit,omitempty"`
+	Precision *int    `json:"precision,omitempty"`
+}
+
+type widgetThreshold struct {
+	Value float64 `json:"value"`
+	Color string  `json:"color"`
+}
+
+type widgetDataSource[T any] struct {
+	Type       string `json:"type"`
+	Properties T      `json:"properties"`
+}
+
+type widgetSeriesProperties struct {
+	Series []widgetSeries `json:"series"`
+}
+
+type kpiWidgetProperties struct {
+	Title      *string           `json:"title,omitempty"`
+	Subtitle   *string           `json:"subtitle,omitempty"`
+	Unit       *string           `json:"unit,omitempty"`
+	LinkUrl    *string           `json:"linkUrl,omitempty"`
+	LinkLabel  *string           `json:"linkLabel,omitempty"`
+	Thresholds []widgetThreshold `json:"thresholds,omitempty"`
+	DataSource widgetDataSource[struct {
+		Series                  []widgetSeries `json:"series"`
+		IsHigherBetter          bool           `json:"isHigherBetter"`
+		IncludePercentageChange bool           `json:"includePercentageChange"`
+	}] `json:"dataSource"`
+}
+
+type timeSeriesWidgetProperties struct {
+	Title    *string `json:"title,omitempty"`
+	Subtitle *string `json:"subtitle,omitempty"`
+	Chart    struct {
+		Type                 string  `json:"type"`
+		Max                  string  `json:"max"`
+		YAxisLabel           *string `json:"yAxisLabel,omitempty"`
+		ShowLegend           bool    `json:"showLegend"`
+		YAxisFormatOverrides struct {
+			ConversionFactor int  `json:"conversionFactor"`
+			Precision        *int `json:"precision,omitempty"`
+		} `json:"yAxisFormatOverrides"`
+		FormatOptions widgetFormatOptions `json:"formatOptions"`
+	} `json:"chart"`
+	DataSource widgetDataSource[widgetSeriesProperties] `json:"dataSource"`
+}
+
+type proportionalWidgetProperties struct {
+	Title         *string                                  `json:"title,omitempty"`
+	Subtitle      *string                                  `json:"subtitle,omitempty"`
+	Type          string                                   `json:"type"`
+	ShowLegend    bool                                     `json:"showLegend"`
+	FormatOptions widgetFormatOptions                      `json:"formatOptions"`
+	DataSource    widgetDataSource[widgetSeriesProperties] `json:"dataSource"`
+}
+
+// typedWidgetType returns the widget type of the typed widget block that is set, or an empty string
+// when the widget is configured with raw properties.
+func (m *dashboardWidgetModel) typedWidgetType() string {
+	switch {
+	case !m.Kpi.IsNull():
+		return widgetTypeKpi
+	case !m.TimeSeries.IsNull():
+		return widgetTypeTimeSeries
+	case !m.Proportional.IsNull():
+		return widgetTypeProportional
+	default:
+		return ""
+	}
+}
+
+// equalIgnoringId returns true if the widgets are equal apart from their ids.
+func (m *dashboardWidgetModel) equalIgnoringId(o dashboardWidgetModel) bool {
+	return m.Key.Equal(o.Key) &&
+		m.Type.Equal(o.Type) &&
+		m.X.Equal(o.X) &&
+		m.Y.Equal(o.Y) &&
+		m.Width.Equal(o.Width) &&
+		m.Height.Equal(o.Height) &&
+		m.Properties.Equal(o.Properties) &&
+		m.Kpi.Equal(o.Kpi) &&
+		m.TimeSeries.Equal(o.TimeSeries) &&
+		m.Proportional.Equal(o.Proportional)
+}
+
+// widgetProperties returns the API properties of the widget from the typed widget block or the raw properties.
+func (m *dashboardWidgetModel) widgetProperties(ctx context.Context, diags *diag.Diagnostics) any {
+	switch m.typedWidgetType() {
+	case widgetTypeKpi:
+		var kpi dashboardKpiWidgetModel
+		diags.Append(m.Kpi.As(ctx, &kpi, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil
+		}
+		return kpi.toProperties()
+	case widgetTypeTimeSeries:
+		var timeSeries dashboardTimeSeriesWidgetModel
+		diags.Append(m.TimeSeries.As(ctx, &timeSeries, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil
+		}
+		return timeSeries.toProperties()
+	case widgetTypeProportional:
+		var proportional dashboardProportionalWidgetModel
+		diags.Append(m.Proportional.As(ctx, &proportional, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil
+		}
+		return proportional.toProperties()
+	}
+
+	// Marshal the json encoded properties string to an object.
+	var props any
+	if err := json.Unmarshal([]byte(m.Properties.ValueString()), &props); err != nil {
+		diags.AddError("swo provider error",
+			fmt.Sprintf("convert plan to API error: %s", err))
+		return nil
+	}
+	return props
+}
+
+// setTypedWidget updates the typed widget block that is set with the API properties of the widget.
+func (m *dashboardWidgetModel) setTypedWidget(ctx context.Context, properties any, diags *diag.Diagnostics) {
+	var d diag.Diagnostics
+	switch m.typedWidgetType() {
+	case widgetTypeKpi:
+		props, err := convertObject[kpiWidgetProperties](properties)
+		if err != nil {
+			diags.AddError("swo provider error", newWidgetPropertiesError(err.Error(), m.Id.ValueString()).Error())
+			return
+		}
+		m.Kpi, d = types.ObjectValueFrom(ctx, KpiWidgetAttributeTypes(), kpiWidgetFromProperties(props))
+	case widgetTypeTimeSeries:
+		props, err := convertObject[timeSeriesWidgetProperties](properties)
+		if err != nil {
+			diags.AddError("swo provider error", newWidgetPropertiesError(err.Error(), m.Id.ValueString()).Error())
+			return
+		}
+		m.TimeSeries, d = types.ObjectValueFrom(ctx, TimeSeriesWidgetAttributeTypes(), timeSeriesWidgetFromProperties(props))
+	case widgetTypeProportional:
+		props, err := convertObject[proportionalWidgetProperties](properties)
+		if err != nil {
+			diags.AddError("swo provider error", newWidgetPropertiesError(err.Error(), m.Id.ValueString()).Error())
+			return
+		}
+		m.Proportional, d = types.ObjectValueFrom(ctx, ProportionalWidgetAttributeTypes(), proportionalWidgetFromProperties(props))
+	}
+	diags.Append(d...)
+}
+
+// setDefaults sets the default values of the optional attributes of the typed widget block that aren't configured.
+func (m *dashboardWidgetModel) setDefaults(ctx context.Context, diags *diag.Diagnostics) {
+	var d diag.Diagnostics
+	switch m.typedWidgetType() {
+	case widgetTypeKpi:
+		var kpi dashboardKpiWidgetModel
+		if !canDecodeTypedWidget(m.Kpi) {
+			return
+		}
+		diags.Append(m.Kpi.As(ctx, &kpi, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return
+		}
+		kpi.IsHigherBetter = boolDefault(kpi.IsHigherBetter, false)
+		kpi.IncludePercentageChange = boolDefault(kpi.IncludePercentageChange, true)
+		setSeriesDefaults(kpi.Series)
+		m.Kpi, d = types.ObjectValueFrom(ctx, KpiWidgetAttributeTypes(), kpi)
+	case widgetTypeTimeSeries:
+		var timeSeries dashboardTimeSeriesWidgetModel
+		if !canDecodeTypedWidget(m.TimeSeries) {
+			return
+		}
+		diags.Append(m.TimeSeries.As(ctx, &timeSeries, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return
+		}
+		timeSeries.ChartType = stringDefault(timeSeries.ChartType, "LineChart")
+		timeSeries.YAxisMax = stringDefault(timeSeries.YAxisMax, "auto")
+		timeSeries.ShowLegend = boolDefault(timeSeries.ShowLegend, true)
+		timeSeries.Precision = int64Default(timeSeries.Precision, 3)
+		setSeriesDefaults(timeSeries.Series)
+		m.TimeSeries, d = types.ObjectValueFrom(ctx, TimeSeriesWidgetAttributeTypes(), timeSeries)
+	case widgetTypeProportional:
+		var proportional dashboardProportionalWidgetModel
+		if !canDecodeTypedWidget(m.Proportional) {
+			return
+		}
+		diags.Append(m.Proportional.As(ctx, &proportional, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return
+		}
+		proportional.ChartType = stringDefault(proportional.ChartType, "HorizontalBar")
+		proportional.ShowLegend = boolDefault(proportional.ShowLegend, false)
+		setSeriesDefaults(proportional.Series)
+		m.Proportional, d = types.ObjectValueFrom(ctx, ProportionalWidgetAttributeTypes(), proportional)
+	}
+	diags.Append(d...)
+}
+
+func setSeriesDefaults(series []dashboardSeriesModel) {
+	for i := range series {
+		s := &series[i]
+		s.Aggregation = stringDefault(s.Aggregation, "AVG")
+		if s.GroupBy.IsNull() || s.GroupBy.IsUnknown() {
+			s.GroupBy = types.ListValueMust(types.StringType, []attr.Value{})
+		}
+		s.Limit = int64Default(s.Limit, 50)
+		s.LimitAscending = boolDefault(s.LimitAscending, false)
+		s.Precision = int64Default(s.Precision, 3)
+	}
+}
+
+// canDecodeTypedWidget returns true if the typed widget block can be decoded into its model, whose lists of
+// thresholds and series can't hold unknown values.
+func canDecodeTypedWidget(value types.Object) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return false
+	}
+	for _, v := range value.Attributes() {
+		if list, ok := v.(types.List); ok && (list.IsUnknown() || slices.ContainsFunc(list.Elements(), attr.Value.IsUnknown)) {
+			return false
+		}
+	}
+	return true
+}
+
+func stringDefault(value types.String, defaultValue string) types.String {
+	if value.IsNull() || value.IsUnknown() {
+		return types.StringValue(defaultValue)
+	}
+	return value
+}
+
+func boolDefault(value types.Bool, defaultValue bool) types.Bool {
+	if value.IsNull() || value.IsUnknown() {
+		return types.BoolValue(defaultValue)
+	}
+	return value
+}
+
+func int64Default(value types.Int64, defaultValue int64) types.Int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return types.Int64Value(defaultValue)
+	}
+	return value
+}
+
+func (m dashboardKpiWidgetModel) toProperties() kpiWidgetProperties {
+	props := kpiWidgetProperties{
+		Title:     m.Title.ValueStringPointer(),
+		Subtitle:  m.Subtitle.ValueStringPointer(),
+		Unit:      m.Unit.ValueStringPointer(),
+		LinkUrl:   m.LinkUrl.ValueStringPointer(),
+		LinkLabel: m.LinkLabel.ValueStringPointer(),
+		Thresholds: convertArray(m.Thresholds, func(t dashboardThresholdModel) widgetThreshold {
+			return widgetThreshold{Value: t.Value.ValueFloat64(), Color: t.Color.ValueString()}
+		}),
+	}
+	props.DataSource.Type = "kpi"
+	props.DataSource.Properties.Series = seriesToProperties(m.Series)
+	props.DataSource.Properties.IsHigherBetter = m.IsHigherBetter.ValueBool()
+	props.DataSource.Properties.IncludePercentageChange = m.IncludePercentageChange.ValueBool()
+	return props
+}
+
+func kpiWidgetFromProperties(props *kpiWidgetProperties) dashboardKpiWidgetModel {
+	return dashboardKpiWidgetModel{
+		Title:                   types.StringPointerValue(props.Title),
+		Subtitle:                types.StringPointerValue(props.Subtitle),
+		Unit:                    types.StringPointerValue(props.Unit),
+		LinkUrl:                 types.StringPointerValue(props.LinkUrl),
+		LinkLabel:               types.StringPointerValue(props.LinkLabel),
+		IsHigherBetter:          types.BoolValue(props.DataSource.Properties.IsHigherBetter),
+		IncludePercentageChange: types.BoolValue(props.DataSource.Properties.IncludePercentageChange),
+		Thresholds: convertArray(props.Thresholds, func(t widgetThreshold) dashboardThresholdModel {
+			return dashboardThresholdModel{Value: types.Float64Value(t.Value), Color: types.StringValue(t.Color)}
+		}),
+		Series: seriesFromProperties(props.DataSource.Properties.Series),
+	}
+}
+
+func (m dashboardTimeSeriesWidgetModel) toProperties() timeSeriesWidgetProperties {
+	precision := toIntPointer(m.Precision)
+	props := timeSeriesWidgetProperties{
+		Title:    m.Title.ValueStringPointer(),
+		Subtitle: m.Subtitle.ValueStringPointer(),
+	}
+	props.Chart.Type = m.ChartType.ValueString()
+	props.Chart.Max = m.YAxisMax.ValueString()
+	props.Chart.YAxisLabel = m.YAxisLabel.ValueStringPointer()
+	props.Chart.ShowLegend = m.ShowLegend.ValueBool()
+	props.Chart.YAxisFormatOverrides.ConversionFactor = 1
+	props.Chart.YAxisFormatOverrides.Precision = precision
+	props.Chart.FormatOptions = widgetFormatOptions{Unit: m.Unit.ValueStringPointer(), Precision: precision}
+	props.DataSource.Type = "timeSeries"
+	props.DataSource.Properties.Series = seriesToProperties(m.Series)
+	return props
+}
+
+func timeSeriesWidgetFromProperties(props *timeSeriesWidgetProperties) dashboardTimeSeriesWidgetModel {
+	return dashboardTimeSeriesWidgetModel{
+		Title:      types.StringPointerValue(props.Title),
+		Subtitle:   types.StringPointerValue(props.Subtitle),
+		ChartType:  types.StringValue(props.Chart.Type),
+		YAxisLabel: types.StringPointerValue(props.Chart.YAxisLabel),
+		YAxisMax:   types.StringValue(props.Chart.Max),
+		ShowLegend: types.BoolValue(props.Chart.ShowLegend),
+		Unit:       types.StringPointerValue(props.Chart.FormatOptions.Unit),
+		Precision:  toInt64Value(props.Chart.FormatOptions.Precision),
+		Series:     seriesFromProperties(props.DataSource.Properties.Series),
+	}
+}
+
+func (m dashboardProportionalWidgetModel) toProperties() proportionalWidgetProperties {
+	props := proportionalWidgetProperties{
+		Title:         m.Title.ValueStringPointer(),
+		Subtitle:      m.Subtitle.ValueStringPointer(),
+		Type:          m.ChartType.ValueString(),
+		ShowLegend:    m.ShowLegend.ValueBool(),
+		FormatOptions: widgetFormatOptions{Unit: m.Unit.ValueStringPointer()},
+	}
+	props.DataSource.Type = "proportional"
+	props.DataSource.Properties.Series = seriesToProperties(m.Series)
+	return props
+}
+
+func proportionalWidgetFromProperties(props *proportionalWidgetProperties) dashboardProportionalWidgetModel {
+	return dashboardProportionalWidgetModel{
+		Title:      types.StringPointerValue(props.Title),
+		Subtitle:   types.StringPointerValue(props.Subtitle),
+		ChartType:  types.StringValue(props.Type),
+		ShowLegend: types.BoolValue(props.ShowLegend),
+		Unit:       types.StringPointerValue(props.FormatOptions.Unit),
+		Series:     seriesFromProperties(props.DataSource.Properties.Series),
+	}
+}
+
+func seriesToProperties(series []dashboardSeriesModel) []widgetSeries {
+	return convertArray(series, func(s dashboardSeriesModel) widgetSeries {
+		return widgetSeries{
+			Type:                "metric",
+			Metric:              s.Metric.ValueString(),
+			AggregationFunction: s.Aggregation.ValueString(),
+			BucketGrouping:      []string{},
+			GroupBy: append([]string{}, convertArray(s.GroupBy.Elements(), func(g attr.Value) string {
+				return g.(types.String).ValueString()
+			})...),
+			Limit: widgetLimit{
+				Value:       int(s.Limit.ValueInt64()),
+				IsAscending: s.LimitAscending.ValueBool(),
+			},
+			FormatOptions: widgetFormatOptions{
+				Unit:      s.Unit.ValueStringPointer(),
+				Precision: toIntPointer(s.Precision),
+			},
+			Color: s.Color.ValueStringPointer(),
+		}
+	})
+}
+
+func seriesFromProperties(series []widgetSeries) []dashboardSeriesModel {
+	return convertArray(series, func(s widgetSeries) dashboardSeriesModel {
+		return dashboardSeriesModel{
+			Metric:         types.StringValue(s.Metric),
+			Aggregation:    types.StringValue(s.AggregationFunction),
+			GroupBy:        types.ListValueMust(types.StringType, convertArray(s.GroupBy, toStringValue)),
+			Limit:          types.Int64Value(int64(s.Limit.Value)),
+			LimitAscending: types.BoolValue(s.Limit.IsAscending),
+			Unit:           types.StringPointerValue(s.FormatOptions.Unit),
+			Precision:      toInt64Value(s.FormatOptions.Precision),
+			Color:          types.StringPointerValue(s.Color),
+		}
+	})
+}
+
+func toIntPointer(value types.Int64) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	v := int(value.ValueInt64())
+	return &v
+}
+
+func toInt64Value(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
@@ -0,0 +1,606 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	swoClient "github.com/solarwinds/swo-client-go/pkg/client"
+)
+
+func TestKpiWidgetPropertiesRoundTrip(t *testing.T) {
+	kpi := dashboardKpiWidgetModel{
+		Title:                   types.StringValue("Kpi Widget"),
+		Subtitle:                types.StringNull(),
+		Unit:                    types.StringValue("ms"),
+		LinkUrl:                 types.StringNull(),
+		LinkLabel:               types.StringNull(),
+		IsHigherBetter:          types.BoolValue(false),
+		IncludePercentageChange: types.BoolValue(true),
+		Thresholds: []dashboardThresholdModel{
+			{Value: types.Float64Value(500), Color: types.StringValue("#e23b3b")},
+		},
+		Series: []dashboardSeriesModel{testDashboardSeries("synthetics.https.response.time")},
+	}
+
+	props, err := convertObject[kpiWidgetProperties](kpi.toProperties())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := kpiWidgetFromProperties(props); !cmp.Equal(got, kpi) {
+		t.Errorf("kpi widget changed after a round trip: %s", cmp.Diff(kpi, got))
+	}
+}
+
+func TestTimeSeriesWidgetFromServerProperties(t *testing.T) {
+	// The properties of a TimeSeries widget created in the dashboard editor.
+	var serverProps any
+	err := json.Unmarshal([]byte(`{
+		"title": "TimeSeries Widget",
+		"chart": {
+			"type": "LineChart",
+			"max": "auto",
+			"yAxisLabel": "",
+			"showLegend": true,
+			"yAxisFormatOverrides": {"conversionFactor": 1, "precision": 3},
+			"formatOptions": {"unit": "ms", "minUnitSize": -2, "precision": 3}
+		},
+		"dataSource": {
+			"type": "timeSeries",
+			"properties": {
+				"series": [{
+					"type": "metric",
+					"metric": "synthetics.https.response.time",
+					"aggregationFunction": "AVG",
+					"bucketGrouping": [],
+					"groupBy": [],
+					"limit": {"value": 50, "isAscending": false},
+					"formatOptions": {"unit": "ms", "minUnitSize": -2, "precision": 3}
+				}]
+			}
+		}
+	}`), &serverProps)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	props, err := convertObject[timeSeriesWidgetProperties](serverProps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := dashboardTimeSeriesWidgetModel{
+		Title:      types.StringValue("TimeSeries Widget"),
+		Subtitle:   types.StringNull(),
+		ChartType:  types.StringValue("LineChart"),
+		YAxisLabel: types.StringValue(""),
+		YAxisMax:   types.StringValue("auto"),
+		ShowLegend: types.BoolValue(true),
+		Unit:       types.StringValue("ms"),
+		Precision:  types.Int64Value(3),
+		Series:     []dashboardSeriesModel{testDashboardSeries("synthetics.https.response.time")},
+	}
+	if got := timeSeriesWidgetFromProperties(props); !cmp.Equal(got, want) {
+		t.Errorf("unexpected TimeSeries widget: %s", cmp.Diff(want, got))
+	}
+}
+
+func TestWidgetSetDefaults(t *testing.T) {
+	ctx := context.Background()
+	series := dashboardSeriesModel{
+		Metric:         types.StringValue("synthetics.https.response.time"),
+		Aggregation:    types.StringUnknown(),
+		GroupBy:        types.ListUnknown(types.StringType),
+		Limit:          types.Int64Unknown(),
+		LimitAscending: types.BoolUnknown(),
+		Unit:           types.StringValue("ms"),
+		Precision:      types.Int64Unknown(),
+		Color:          types.StringNull(),
+	}
+	timeSeries := dashboardTimeSeriesWidgetModel{
+		Title:      types.StringValue("TimeSeries Widget"),
+		Subtitle:   types.StringNull(),
+		ChartType:  types.StringValue("AreaChart"),
+		YAxisLabel: types.StringNull(),
+		YAxisMax:   types.StringUnknown(),
+		ShowLegend: types.BoolNull(),
+		Unit:       types.StringValue("ms"),
+		Precision:  types.Int64Unknown(),
+		Series:     []dashboardSeriesModel{series},
+	}
+	tfTimeSeries, d := types.ObjectValueFrom(ctx, TimeSeriesWidgetAttributeTypes(), timeSeries)
+	if d.HasError() {
+		t.Fatal(d)
+	}
+	widget := dashboardWidgetModel{
+		Kpi:          types.ObjectNull(KpiWidgetAttributeTypes()),
+		TimeSeries:   tfTimeSeries,
+		Proportional: types.ObjectNull(ProportionalWidgetAttributeTypes()),
+	}
+
+	var diags diag.Diagnostics
+	widget.setDefaults(ctx, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	var got dashboardTimeSeriesWidgetModel
+	if d := widget.TimeSeries.As(ctx, &got, basetypes.ObjectAsOptions{}); d.HasError() {
+		t.Fatal(d)
+	}
+
+	want := timeSeries
+	want.YAxisMax = types.StringValue("auto")
+	want.ShowLegend = types.BoolValue(true)
+	want.Precision = types.Int64Value(3)
+	want.Series = []dashboardSeriesModel{testDashboardSeries("synthetics.https.response.time")}
+	if !cmp.Equal(got, want) {
+		t.Errorf("unexpected defaults of the TimeSeries widget: %s", cmp.Diff(want, got))
+	}
+}
+
+func testDashboardSeries(metric string) dashboardSeriesModel {
+	return dashboardSeriesModel{
+		Metric:         types.StringValue(metric),
+		Aggregation:    types.StringValue("AVG"),
+		GroupBy:        types.ListValueMust(types.StringType, []attr.Value{}),
+		Limit:          types.Int64Value(50),
+		LimitAscending: types.BoolValue(false),
+		Unit:           types.StringValue("ms"),
+		Precision:      types.Int64Value(3),
+		Color:          types.StringNull(),
+	}
+}
+
+// fakeDashboardServer is an in-memory implementation of the dashboard GraphQL operations.
+type fakeDashboardServer struct {
+	mu         sync.Mutex
+	dashboards map[string]map[string]any
+}
+
+func newFakeDashboardServer(t *testing.T) (*fakeDashboardServer, *swoClient.Client) {
+	t.Helper()
+	fake := &fakeDashboardServer{dashboards: map[string]map[string]any{}}
+	server := httptest.NewServer(http.HandlerFunc(fake.serveHTTP))
+	t.Cleanup(server.Close)
+
+	client, err := swoClient.New("TOKEN", swoClient.BaseUrlOption(server.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fake, client
+}
+
+func (f *fakeDashboardServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		OperationName string `json:"operationName"`
+		Variables     struct {
+			Id    string         `json:"id"`
+			Input map[string]any `json:"input"`
+		} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	mutationResponse := func(dashboard map[string]any) map[string]any {
+		return map[string]any{"code": "200", "success": true, "message": "", "dashboard": dashboard}
+	}
+
+	var data map[string]any
+	switch req.OperationName {
+	case "createDashboard":
+		dashboard := req.Variables.Input
+		dashboard["id"] = fmt.Sprintf("dashboard-%d", len(f.dashboards)+1)
+		dashboard["createdAt"] = "2024-01-01T00:00:00Z"
+		dashboard["updatedAt"] = "2024-01-01T00:00:00Z"
+		f.dashboards[dashboard["id"].(string)] = dashboard
+		data = map[string]any{"createDashboard": mutationResponse(dashboard)}
+	case "updateDashboard":
+		dashboard := f.dashboards[req.Variables.Input["id"].(string)]
+		for k, v := range req.Variables.Input {
+			dashboard[k] = v
+		}
+		data = map[string]any{"updateDashboard": mutationResponse(dashboard)}
+	case "getDashboardById":
+		data = map[string]any{"dashboards": map[string]any{"byIdOrSystemReference": f.dashboards[req.Variables.Id]}}
+	case "deleteDashboard":
+		delete(f.dashboards, req.Variables.Input["id"].(string))
+		data = map[string]any{"deleteDashboard": map[string]any{"code": "200", "success": true, "message": ""}}
+	default:
+		http.Error(w, "unknown operation "+req.OperationName, http.StatusBadRequest)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
+
+// dashboard returns the stored dashboard with the given id.
+func (f *fakeDashboardServer) dashboard(id string) map[string]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.dashboards[id]
+}
+
+func testRawWidget(widgetType string, x int64, properties string) dashboardWidgetModel {
+	return dashboardWidgetModel{
+		Id:           types.StringUnknown(),
+		Key:          types.StringNull(),
+		Type:         types.StringValue(widgetType),
+		X:            types.Int64Value(x),
+		Y:            types.Int64Value(0),
+		Width:        types.Int64Value(4),
+		Height:       types.Int64Value(2),
+		Properties:   types.StringValue(properties),
+		Kpi:          types.ObjectNull(KpiWidgetAttributeTypes()),
+		TimeSeries:   types.ObjectNull(TimeSeriesWidgetAttributeTypes()),
+		Proportional: types.ObjectNull(ProportionalWidgetAttributeTypes()),
+	}
+}
+
+func testDashboardPlan(t *testing.T, tfSchema schema.Schema, widgets []dashboardWidgetModel) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	tfWidgets, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: WidgetAttributeTypes()}, widgets)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	plan := tfsdk.Plan{Schema: tfSchema, Raw: tftypes.NewValue(tfSchema.Type().TerraformType(ctx), nil)}
+	diags = plan.Set(ctx, &dashboardResourceModel{
+		Id:            types.StringUnknown(),
+		Name:          types.StringValue("runbook"),
+		IsPrivate:     types.BoolValue(false),
+		CategoryId:    types.StringNull(),
+		Widgets:       tfWidgets,
+		Variables:     types.ListNull(types.ObjectType{AttrTypes: VariableAttributeTypes()}),
+		GlobalFilters: types.ListNull(types.ObjectType{AttrTypes: GlobalFilterAttributeTypes()}),
+		Version:       types.Int64Null(),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return plan
+}
+
+func TestDashboardResourceWidgetTypesFakeServer(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newFakeDashboardServer(t)
+	r := &dashboardResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	tfSchema := schemaResp.Schema
+	nullState := func() tfsdk.State {
+		return tfsdk.State{Schema: tfSchema, Raw: tftypes.NewValue(tfSchema.Type().TerraformType(ctx), nil)}
+	}
+
+	plan := testDashboardPlan(t, tfSchema, []dashboardWidgetModel{
+		testRawWidget("Markdown", 0, `{"text":"# Runbook\n1. Check the logs"}`),
+		testRawWidget("Logs", 4, `{"query":"service:checkout level:error","title":"Errors"}`),
+		testRawWidget("Table", 8, `{"columns":["host.name"],"title":"Hosts"}`),
+	})
+
+	// Create
+	createResp := resource.CreateResponse{State: nullState()}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned errors: %v", createResp.Diagnostics)
+	}
+
+	var created dashboardResourceModel
+	createResp.State.Get(ctx, &created)
+	var createdWidgets []dashboardWidgetModel
+	created.Widgets.ElementsAs(ctx, &createdWidgets, false)
+	for _, w := range createdWidgets {
+		if w.Id.IsUnknown() || w.Id.IsNull() {
+			t.Errorf("widget %s has no id after Create", w.Type.ValueString())
+		}
+	}
+
+	// Read returns the same widgets.
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned errors: %v", readResp.Diagnostics)
+	}
+	var read dashboardResourceModel
+	readResp.State.Get(ctx, &read)
+	if !read.Widgets.Equal(created.Widgets) {
+		t.Errorf("widgets changed after Read:\n%s\n%s", created.Widgets, read.Widgets)
+	}
+
+	// Widgets changed in the UI are reconciled on Read, including widgets of types the provider doesn't know.
+	dashboard := fake.dashboard(created.Id.ValueString())
+	widgets := dashboard["widgets"].([]any)
+	layout := dashboard["layout"].([]any)
+	dashboard["widgets"] = append(widgets[1:], map[string]any{
+		"id": "ui-widget", "type": "ServiceMap", "properties": map[string]any{"title": "Latency"},
+	})
+	dashboard["layout"] = append(layout[1:], map[string]any{
+		"id": "ui-widget", "x": 0, "y": 2, "width": 12, "height": 2,
+	})
+	removedId := widgets[0].(map[string]any)["id"].(string)
+
+	readResp = resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned errors: %v", readResp.Diagnostics)
+	}
+	readResp.State.Get(ctx, &read)
+	var readWidgets []dashboardWidgetModel
+	read.Widgets.ElementsAs(ctx, &readWidgets, false)
+
+	got := map[string]attr.Value{}
+	gotTypes := map[string]string{}
+	for _, w := range readWidgets {
+		got[w.Id.ValueString()] = w.Properties
+		gotTypes[w.Id.ValueString()] = w.Type.ValueString()
+	}
+	if len(got) != 3 {
+		t.Errorf("expected 3 widgets after Read, got %d", len(got))
+	}
+	if _, found := got[removedId]; found {
+		t.Errorf("widget %s removed in the UI is still in the state", removedId)
+	}
+	if props := got["ui-widget"]; !props.Equal(types.StringValue(`{"title":"Latency"}`)) {
+		t.Errorf("widget added in the UI has properties %s", props)
+	}
+	if gotTypes["ui-widget"] != "ServiceMap" {
+		t.Errorf("widget added in the UI has type %s", gotTypes["ui-widget"])
+	}
+}
+
+func TestDashboardResourceUpdateReusesWidgetIds(t *testing.T) {
+	ctx := context.Background()
+	_, client := newFakeDashboardServer(t)
+	r := &dashboardResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	tfSchema := schemaResp.Schema
+
+	markdown := testRawWidget("Markdown", 0, `{"text":"# Runbook"}`)
+	logs := testRawWidget("Logs", 4, `{"query":"level:error"}`)
+
+	createResp := resource.CreateResponse{
+		State: tfsdk.State{Schema: tfSchema, Raw: tftypes.NewValue(tfSchema.Type().TerraformType(ctx), nil)},
+	}
+	r.Create(ctx, resource.CreateRequest{Plan: testDashboardPlan(t, tfSchema, []dashboardWidgetModel{markdown, logs})}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned errors: %v", createResp.Diagnostics)
+	}
+	createdIds := testWidgetIdsByType(t, createResp.State)
+
+	// The logs widget moves, the markdown widget is unchanged.
+	logs.X = types.Int64Value(8)
+	plan := testDashboardPlan(t, tfSchema, []dashboardWidgetModel{markdown, logs})
+	var stateId types.String
+	createResp.State.GetAttribute(ctx, path.Root("id"), &stateId)
+	plan.SetAttribute(ctx, path.Root("id"), stateId)
+
+	updateResp := resource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: createResp.State}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update() returned errors: %v", updateResp.Diagnostics)
+	}
+	updatedIds := testWidgetIdsByType(t, updateResp.State)
+
+	if updatedIds["Markdown"] != createdIds["Markdown"] {
+		t.Errorf("the id of the unchanged widget changed from %s to %s",
+			createdIds["Markdown"], updatedIds["Markdown"])
+	}
+	if updatedIds["Logs"] == "" {
+		t.Errorf("the moved widget has no id")
+	}
+}
+
+func testWidgetIdsByType(t *testing.T, state tfsdk.State) map[string]string {
+	t.Helper()
+	ctx := context.Background()
+
+	var model dashboardResourceModel
+	if diags := state.Get(ctx, &model); diags.HasError() {
+		t.Fatal(diags)
+	}
+	var widgets []dashboardWidgetModel
+	model.Widgets.ElementsAs(ctx, &widgets, false)
+
+	ids := map[string]string{}
+	for _, w := range widgets {
+		ids[w.Type.ValueString()] = w.Id.ValueString()
+	}
+	return ids
+}
+
+func TestWidgetsFromPlanUsesWidgetIdsByKey(t *testing.T) {
+	ctx := context.Background()
+
+	first := testRawWidget("Markdown", 0, `{"text":"same"}`)
+	first.Key = types.StringValue("first")
+	second := testRawWidget("Markdown", 0, `{"text":"same"}`)
+	second.Key = types.StringValue("second")
+	third := testRawWidget("Logs", 4, `{"query":"level:error"}`)
+
+	tfWidgets, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: WidgetAttributeTypes()},
+		[]dashboardWidgetModel{first, second, third})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	plan := dashboardResourceModel{Widgets: tfWidgets}
+
+	widgets, layouts := widgetsFromPlan(ctx, &plan, map[string]string{"first": "id-1", "second": "id-2"}, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	ids := map[string]bool{}
+	for i := range widgets {
+		if widgets[i].Id != layouts[i].Id {
+			t.Errorf("widget id %s doesn't match layout id %s", widgets[i].Id, layouts[i].Id)
+		}
+		ids[widgets[i].Id] = true
+	}
+	if !ids["id-1"] || !ids["id-2"] || len(ids) != 3 {
+		t.Errorf("unexpected widget ids %v", ids)
+	}
+
+	// Identical widgets are matched back by id.
+	dashboard := &swoClient.CreateDashboardResult{Id: "dashboard"}
+	for i := range widgets {
+		dashboard.Widgets = append(dashboard.Widgets, swoClient.CreateDashboardWidget{Id: widgets[i].Id, Type: widgets[i].Type})
+		dashboard.Layout = append(dashboard.Layout, swoClient.CreateDashboardLayout{
+			Id: layouts[i].Id, X: layouts[i].X, Y: layouts[i].Y, Width: layouts[i].Width, Height: layouts[i].Height,
+		})
+	}
+	setDashboardValuesFromCreate(ctx, dashboard, &plan, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	got := widgetIdsByKey(ctx, plan.Widgets, &diags)
+	if got["first"] != "id-1" || got["second"] != "id-2" || len(got) != 2 {
+		t.Errorf("widgetIdsByKey() = %v", got)
+	}
+}
+
+func TestDashboardResourcePrivacyChangeRecreates(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newFakeDashboardServer(t)
+	r := &dashboardResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	tfSchema := schemaResp.Schema
+
+	widgets := []dashboardWidgetModel{testRawWidget("Markdown", 0, `{"text":"# Runbook"}`)}
+	createResp := resource.CreateResponse{
+		State: tfsdk.State{Schema: tfSchema, Raw: tftypes.NewValue(tfSchema.Type().TerraformType(ctx), nil)},
+	}
+	r.Create(ctx, resource.CreateRequest{Plan: testDashboardPlan(t, tfSchema, widgets)}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned errors: %v", createResp.Diagnostics)
+	}
+	var created dashboardResourceModel
+	createResp.State.Get(ctx, &created)
+
+	plan := testDashboardPlan(t, tfSchema, widgets)
+	plan.SetAttribute(ctx, path.Root("is_private"), types.BoolValue(true))
+
+	updateResp := resource.UpdateResponse{State: createResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: createResp.State}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update() returned errors: %v", updateResp.Diagnostics)
+	}
+	var updated dashboardResourceModel
+	updateResp.State.Get(ctx, &updated)
+
+	if updated.Id.Equal(created.Id) {
+		t.Fatalf("the dashboard wasn't recreated, id %s", updated.Id)
+	}
+	if fake.dashboard(created.Id.ValueString()) != nil {
+		t.Errorf("the replaced dashboard %s wasn't deleted", created.Id)
+	}
+	if isPrivate := fake.dashboard(updated.Id.ValueString())["isPrivate"]; isPrivate != true {
+		t.Errorf("the new dashboard has isPrivate %v", isPrivate)
+	}
+}
+
+// testPrivateState is an in-memory private state.
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+	} else {
+		p[key] = value
+	}
+	return nil
+}
+
+func TestRetryDeleteReplacedDashboard(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newFakeDashboardServer(t)
+	r := &dashboardResource{client: client}
+
+	replaced, err := client.DashboardsService().Create(ctx, swoClient.CreateDashboardInput{Name: "replaced"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	private := testPrivateState{dashboardReplacedIdKey: []byte(`"` + replaced.Id + `"`)}
+
+	var diags diag.Diagnostics
+	r.retryDeleteReplacedDashboard(ctx, private, private, &diags)
+	if diags.HasError() {
+		t.Fatalf("retryDeleteReplacedDashboard() returned errors: %v", diags)
+	}
+	if fake.dashboard(replaced.Id) != nil {
+		t.Errorf("the replaced dashboard %s wasn't deleted", replaced.Id)
+	}
+	if _, found := private[dashboardReplacedIdKey]; found {
+		t.Error("the replaced dashboard id is still in the private state")
+	}
+}
+
+func TestDashboardResourceDeleteDeletesReplacedDashboard(t *testing.T) {
+	ctx := context.Background()
+	fake, client := newFakeDashboardServer(t)
+	r := &dashboardResource{client: client}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	tfSchema := schemaResp.Schema
+
+	replaced, err := client.DashboardsService().Create(ctx, swoClient.CreateDashboardInput{Name: "replaced"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	widgets := []dashboardWidgetModel{testRawWidget("Markdown", 0, `{"text":"# Runbook"}`)}
+	createResp := resource.CreateResponse{
+		State: tfsdk.State{Schema: tfSchema, Raw: tftypes.NewValue(tfSchema.Type().TerraformType(ctx), nil)},
+	}
+	r.Create(ctx, resource.CreateRequest{Plan: testDashboardPlan(t, tfSchema, widgets)}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() returned errors: %v", createResp.Diagnostics)
+	}
+	var created dashboardResourceModel
+	createResp.State.Get(ctx, &created)
+
+	// The private state is internal to the framework, so the zero value of its type is used.
+	deleteReq := resource.DeleteRequest{State: createResp.State}
+	reflect.ValueOf(&deleteReq.Private).Elem().Set(reflect.New(reflect.TypeOf(deleteReq.Private).Elem()))
+	deleteReq.Private.SetKey(ctx, dashboardReplacedIdKey, []byte(`"`+replaced.Id+`"`))
+
+	deleteResp := resource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, deleteReq, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete() returned errors: %v", deleteResp.Diagnostics)
+	}
+	if fake.dashboard(replaced.Id) != nil {
+		t.Errorf("the replaced dashboard %s wasn't deleted", replaced.Id)
+	}
+	if fake.dashboard(created.Id.ValueString()) != nil {
+		t.Errorf("the dashboard %s wasn't deleted", created.Id)
+	}
+}
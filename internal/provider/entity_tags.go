@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/solarwinds/swo-sdk-go/swov1/models/components"
+)
+
+// entityTag is a key-value tag of a DEM entity (websites and uris).
+type entityTag struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+func EntityTagAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key":   types.StringType,
+		"value": types.StringType,
+	}
+}
+
+// commonTagsFromSet returns the tags of the tags attribute for the DEM API.
+func commonTagsFromSet(ctx context.Context, tfTags types.Set, diags *diag.Diagnostics) []components.CommonTag {
+	var tags []entityTag
+	diags.Append(tfTags.ElementsAs(ctx, &tags, false)...)
+	return convertArray(tags, func(e entityTag) components.CommonTag {
+		return components.CommonTag{
+			Key:   e.Key.ValueString(),
+			Value: e.Value.ValueString(),
+		}
+	})
+}
+
+// commonTagsToSet returns the tags attribute of the tags returned by the DEM API. No tags are a null set.
+func commonTagsToSet(ctx context.Context, tags []components.CommonTag, diags *diag.Diagnostics) types.Set {
+	var tagElements []attr.Value
+	for _, x := range tags {
+		objectValue, d := types.ObjectValueFrom(ctx, EntityTagAttributeTypes(), entityTag{
+			Key:   types.StringValue(x.Key),
+			Value: types.StringValue(x.Value),
+		})
+		diags.Append(d...)
+		if diags.HasError() {
+			return types.SetNull(types.ObjectType{AttrTypes: EntityTagAttributeTypes()})
+		}
+		tagElements = append(tagElements, objectValue)
+	}
+
+	tfTags, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: EntityTagAttributeTypes()}, tagElements)
+	diags.Append(d...)
+	return tfTags
+}
+
+// entityTagsConfig is the tag configuration of the provider, which applies to every taggable entity.
+type entityTagsConfig struct {
+	// Tags that are merged into the tags of every entity. The tags of an entity override default tags with the
+	// same key.
+	DefaultTags map[string]string
+	// Keys and key prefixes of tags that are managed outside terraform, and are ignored when read.
+	IgnoreKeys        []string
+	IgnoreKeyPrefixes []string
+}
+
+// ignored returns whether tags with the key are ignored.
+func (c *entityTagsConfig) ignored(key string) bool {
+	if c == nil {
+		return false
+	}
+	if slices.Contains(c.IgnoreKeys, key) {
+		return true
+	}
+	return slices.ContainsFunc(c.IgnoreKeyPrefixes, func(prefix string) bool { return strings.HasPrefix(key, prefix) })
+}
+
+// merged returns the default tags merged with the tags of an entity, sorted by key.
+func (c *entityTagsConfig) merged(tags []components.CommonTag) []components.CommonTag {
+	var result []components.CommonTag
+	if c != nil {
+		for key, value := range c.DefaultTags {
+			if !slices.ContainsFunc(tags, func(t components.CommonTag) bool { return t.Key == key }) {
+				result = append(result, components.CommonTag{Key: key, Value: value})
+			}
+		}
+	}
+	result = append(result, tags...)
+	slices.SortFunc(result, func(a, b components.CommonTag) int { return strings.Compare(a.Key, b.Key) })
+	return result
+}
+
+// modifyPlan plans 'tags_all' as the default tags merged with the planned tags.
+func (c *entityTagsConfig) modifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tfTags types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tfTags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll := types.SetUnknown(types.ObjectType{AttrTypes: EntityTagAttributeTypes()})
+	if isFullyKnown(ctx, tfTags) {
+		tagsAll = commonTagsToSet(ctx, c.merged(commonTagsFromSet(ctx, tfTags, &resp.Diagnostics)), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// stateTags returns 'tags' and 'tags_all' of the tags returned by the DEM API. Ignored tags are left out, and
+// default tags are only part of 'tags' when they are configured as tags of the entity.
+func (c *entityTagsConfig) stateTags(ctx context.Context, serverTags []components.CommonTag, priorTags types.Set, diags *diag.Diagnostics) (types.Set, types.Set) {
+	var prior []components.CommonTag
+	if !priorTags.IsNull() && !priorTags.IsUnknown() {
+		prior = commonTagsFromSet(ctx, priorTags, diags)
+	}
+
+	var tags, tagsAll []components.CommonTag
+	for _, tag := range serverTags {
+		if c.ignored(tag.Key) {
+			continue
+		}
+		tagsAll = append(tagsAll, tag)
+
+		configured := slices.ContainsFunc(prior, func(t components.CommonTag) bool { return t.Key == tag.Key })
+		if c != nil && !configured {
+			if value, found := c.DefaultTags[tag.Key]; found && value == tag.Value {
+				continue
+			}
+		}
+		tags = append(tags, tag)
+	}
+
+	tfTags := commonTagsToSet(ctx, tags, diags)
+	if tfTags.IsNull() && !priorTags.IsNull() && !priorTags.IsUnknown() {
+		tfTags = types.SetValueMust(types.ObjectType{AttrTypes: EntityTagAttributeTypes()}, []attr.Value{})
+	}
+	return tfTags, commonTagsToSet(ctx, tagsAll, diags)
+}
+
+// ignoresTags returns whether any tags are ignored.
+func (c *entityTagsConfig) ignoresTags() bool {
+	return c != nil && (len(c.IgnoreKeys) > 0 || len(c.IgnoreKeyPrefixes) > 0)
+}
+
+// ignoredTags returns the tags that are ignored, so they are kept when an entity is updated.
+func (c *entityTagsConfig) ignoredTags(serverTags []components.CommonTag) []components.CommonTag {
+	var tags []components.CommonTag
+	for _, tag := range serverTags {
+		if c.ignored(tag.Key) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
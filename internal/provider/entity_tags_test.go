@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/solarwinds/swo-sdk-go/swov1/models/components"
+)
+
+func testEntityTagsConfig() *entityTagsConfig {
+	return &entityTagsConfig{
+		DefaultTags:       map[string]string{"team": "payments", "env": "prod"},
+		IgnoreKeys:        []string{"swo.managed"},
+		IgnoreKeyPrefixes: []string{"automation:"},
+	}
+}
+
+func TestEntityTagsConfigMerged(t *testing.T) {
+	got := testEntityTagsConfig().merged([]components.CommonTag{
+		{Key: "env", Value: "staging"},
+		{Key: "cost-center", Value: "42"},
+	})
+	want := []components.CommonTag{
+		{Key: "cost-center", Value: "42"},
+		{Key: "env", Value: "staging"},
+		{Key: "team", Value: "payments"},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("merged() mismatch (-got +want):\n%s", cmp.Diff(got, want))
+	}
+
+	var config *entityTagsConfig
+	if got := config.merged(nil); got != nil {
+		t.Errorf("merged() of a nil config = %v, want nil", got)
+	}
+}
+
+func TestEntityTagsConfigStateTags(t *testing.T) {
+	ctx := context.Background()
+	config := testEntityTagsConfig()
+	serverTags := []components.CommonTag{
+		{Key: "team", Value: "payments"},
+		{Key: "env", Value: "prod"},
+		{Key: "cost-center", Value: "42"},
+		{Key: "swo.managed", Value: "true"},
+		{Key: "automation:owner", Value: "bot"},
+	}
+
+	tagKeys := func(tfTags types.Set) []string {
+		t.Helper()
+		var diags diag.Diagnostics
+		var keys []string
+		for _, tag := range commonTagsFromSet(ctx, tfTags, &diags) {
+			keys = append(keys, tag.Key)
+		}
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		sort.Strings(keys)
+		return keys
+	}
+
+	var diags diag.Diagnostics
+	priorTags := commonTagsToSet(ctx, []components.CommonTag{{Key: "env", Value: "prod"}, {Key: "cost-center", Value: "42"}}, &diags)
+	tags, tagsAll := config.stateTags(ctx, serverTags, priorTags, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	// The default 'team' tag isn't configured for the entity, 'env' is configured with the default value.
+	if got, want := tagKeys(tags), []string{"cost-center", "env"}; !cmp.Equal(got, want) {
+		t.Errorf("tags = %v, want %v", got, want)
+	}
+	if got, want := tagKeys(tagsAll), []string{"cost-center", "env", "team"}; !cmp.Equal(got, want) {
+		t.Errorf("tags_all = %v, want %v", got, want)
+	}
+
+	// A changed default tag is drift of the entity tags.
+	tags, _ = config.stateTags(ctx, []components.CommonTag{{Key: "team", Value: "search"}}, types.SetNull(priorTags.ElementType(ctx)), &diags)
+	if got, want := tagKeys(tags), []string{"team"}; !cmp.Equal(got, want) {
+		t.Errorf("tags = %v, want %v", got, want)
+	}
+}
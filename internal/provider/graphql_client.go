@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrMutationFailed = errors.New("mutation failed")
+)
+
+// gqlClient issues GraphQL operations that are not yet exposed by the swo-client-go library.
+// It talks to the same endpoint, with the same credentials, as the swoClient.
+type gqlClient struct {
+	client graphql.Client
+}
+
+// gqlMutationResponse holds the fields shared by every SWO MutationResponseInterface.
+type gqlMutationResponse struct {
+	Code    string `json:"code"`
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+func (m gqlMutationResponse) err(localMessage string) error {
+	if m.Success {
+		return nil
+	}
+	return fmt.Errorf("%w: %s. code: %s message: %s", ErrMutationFailed, localMessage, m.Code, m.Message)
+}
+
+// bearerTokenTransport authenticates all requests with the SWO api token.
+type bearerTokenTransport struct {
+	apiToken string
+	base     http.RoundTripper
+}
+
+func (t *bearerTokenTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	// The original request must not be modified. See https://pkg.go.dev/net/http#RoundTripper.
+	clone := request.Clone(request.Context())
+	clone.Header.Set("Authorization", "Bearer "+t.apiToken)
+	clone.Header.Set("X-Request-Id", uuid.NewString())
+
+	return t.base.RoundTrip(clone)
+}
+
+func newGqlClient(endpoint string, httpClient *http.Client) *gqlClient {
+	return &gqlClient{
+		client: graphql.NewClient(endpoint, httpClient),
+	}
+}
+
+// gqlDo executes the GraphQL operation and unmarshals the data payload into a value of type T.
+func gqlDo[T any](ctx context.Context, c *gqlClient, opName string, query string, variables map[string]any) (*T, error) {
+	var data json.RawMessage
+	resp := graphql.Response{Data: &data}
+
+	err := c.client.MakeRequest(ctx, &graphql.Request{
+		OpName:    opName,
+		Query:     query,
+		Variables: variables,
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	var result T
+	if err = json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMarshal, err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestGqlClient(t *testing.T, handler http.HandlerFunc) *gqlClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return newGqlClient(server.URL, &http.Client{
+		Transport: &bearerTokenTransport{apiToken: "TOKEN", base: http.DefaultTransport},
+	})
+}
+
+func TestGqlDoMutation(t *testing.T) {
+	type testResponse struct {
+		TestNotificationServiceConfiguration gqlMutationResponse `json:"testNotificationServiceConfiguration"`
+	}
+
+	tests := []struct {
+		name    string
+		success bool
+		wantErr error
+	}{
+		{name: "success", success: true},
+		{name: "failure", success: false, wantErr: ErrMutationFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestGqlClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "Bearer TOKEN" {
+					t.Errorf("Authorization header = %q", got)
+				}
+
+				var req struct {
+					OperationName string         `json:"operationName"`
+					Variables     map[string]any `json:"variables"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Fatalf("decoding request: %s", err)
+				}
+				if req.OperationName != "testNotification" {
+					t.Errorf("operationName = %q", req.OperationName)
+				}
+
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"data": map[string]any{
+						"testNotificationServiceConfiguration": map[string]any{
+							"code":    "200",
+							"success": tt.success,
+							"message": "delivery status",
+						},
+					},
+				})
+			})
+
+			resp, err := gqlDo[testResponse](context.Background(), client, "testNotification", testNotificationMutation,
+				map[string]any{"input": map[string]any{"type": "slack"}})
+			if err != nil {
+				t.Fatalf("gqlDo() error = %s", err)
+			}
+
+			err = resp.TestNotificationServiceConfiguration.err("test notification failed")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -28,6 +29,7 @@ type notificationSettings struct {
 	Pushover              types.Object `tfsdk:"pushover"`
 	SolarWindsServiceDesk types.Object `tfsdk:"swsd"`
 	ServiceNow            types.Object `tfsdk:"servicenow"`
+	Generic               types.Object `tfsdk:"generic"`
 }
 
 func NotificationSettingsAttributeTypes() map[string]attr.Type {
@@ -43,6 +45,7 @@ func NotificationSettingsAttributeTypes() map[string]attr.Type {
 		"pushover":   types.ObjectType{AttrTypes: PushoverAttributeTypes()},
 		"swsd":       types.ObjectType{AttrTypes: SolarWindsServiceDeskAttributeTypes()},
 		"servicenow": types.ObjectType{AttrTypes: ServiceNowAttributeTypes()},
+		"generic":    types.ObjectType{AttrTypes: GenericAttributeTypes()},
 	}
 }
 
@@ -152,23 +155,29 @@ func PagerDutyAttributeTypes() map[string]attr.Type {
 }
 
 type notificationSettingsWebhook struct {
-	Url             types.String `tfsdk:"url" `
-	Method          types.String `tfsdk:"method"`
-	AuthType        types.String `tfsdk:"auth_type"`
-	AuthUsername    types.String `tfsdk:"auth_username"`
-	AuthPassword    types.String `tfsdk:"auth_password"`
-	AuthHeaderName  types.String `tfsdk:"auth_header_name"`
-	AuthHeaderValue types.String `tfsdk:"auth_header_value"`
+	Url             types.String         `tfsdk:"url" `
+	Method          types.String         `tfsdk:"method"`
+	AuthType        types.String         `tfsdk:"auth_type"`
+	AuthUsername    types.String         `tfsdk:"auth_username"`
+	AuthPassword    types.String         `tfsdk:"auth_password"`
+	AuthHeaderName  types.String         `tfsdk:"auth_header_name"`
+	AuthHeaderValue types.String         `tfsdk:"auth_header_value"`
+	ContentType     types.String         `tfsdk:"content_type"`
+	Headers         types.Map            `tfsdk:"headers"`
+	PayloadTemplate webhookTemplateValue `tfsdk:"payload_template"`
 }
 
 type clientWebhook struct {
-	Url             string `tfsdk:"url" json:"url"`
-	Method          string `tfsdk:"method" json:"method"`
-	AuthType        string `tfsdk:"auth_type" json:"authType"`
-	AuthUsername    string `tfsdk:"auth_username" json:"authUsername"`
-	AuthPassword    string `tfsdk:"auth_password" json:"authPassword"`
-	AuthHeaderName  string `tfsdk:"auth_header_name" json:"authHeaderName"`
-	AuthHeaderValue string `tfsdk:"auth_header_value" json:"authHeaderValue"`
+	Url             string            `tfsdk:"url" json:"url"`
+	Method          string            `tfsdk:"method" json:"method"`
+	AuthType        string            `tfsdk:"auth_type" json:"authType"`
+	AuthUsername    string            `tfsdk:"auth_username" json:"authUsername"`
+	AuthPassword    string            `tfsdk:"auth_password" json:"authPassword"`
+	AuthHeaderName  string            `tfsdk:"auth_header_name" json:"authHeaderName"`
+	AuthHeaderValue string            `tfsdk:"auth_header_value" json:"authHeaderValue"`
+	ContentType     string            `tfsdk:"content_type" json:"contentType,omitempty"`
+	Headers         map[string]string `tfsdk:"headers" json:"headers,omitempty"`
+	PayloadTemplate string            `tfsdk:"payload_template" json:"payloadTemplate,omitempty"`
 }
 
 func WebhookAttributeTypes() map[string]attr.Type {
@@ -180,9 +189,43 @@ func WebhookAttributeTypes() map[string]attr.Type {
 		"auth_password":     types.StringType,
 		"auth_header_name":  types.StringType,
 		"auth_header_value": types.StringType,
+		"content_type":      types.StringType,
+		"headers":           types.MapType{ElemType: types.StringType},
+		"payload_template":  webhookTemplateType{},
 	}
 }
 
+// setTemplateSettings copies the optional payload settings from the client model. Empty values are
+// stored as null so that webhooks without a custom payload don't drift. The default content type is
+// stored as null when the content type wasn't set, and the template is kept when it's equal to the
+// one that was set.
+func (w *notificationSettingsWebhook) setTemplateSettings(settings *clientWebhook, ctx context.Context, diags *diag.Diagnostics) {
+	if !w.ContentType.IsNull() || settings.ContentType != webhookContentTypeJson {
+		w.ContentType = stringValueOrNull(settings.ContentType)
+	}
+
+	template := webhookTemplateValueOrNull(settings.PayloadTemplate)
+	if w.ContentType.IsNull() || w.ContentType.ValueString() == webhookContentTypeJson {
+		template = webhookTemplateValueOrNull(normalizeWebhookJsonTemplate(settings.PayloadTemplate))
+	}
+	equal, d := w.PayloadTemplate.StringSemanticEquals(ctx, template)
+	diags.Append(d...)
+	if !equal {
+		w.PayloadTemplate = template
+	}
+
+	if len(settings.Headers) == 0 {
+		w.Headers = types.MapNull(types.StringType)
+		return
+	}
+	headers, d := types.MapValueFrom(ctx, types.StringType, settings.Headers)
+	if d.HasError() {
+		diags.Append(d...)
+		return
+	}
+	w.Headers = headers
+}
+
 type notificationSettingsAmazonSNS struct {
 	TopicARN        types.String `tfsdk:"topic_arn"`
 	AccessKeyID     types.String `tfsdk:"access_key_id"`
@@ -268,6 +311,20 @@ func ServiceNowAttributeTypes() map[string]attr.Type {
 	}
 }
 
+type notificationSettingsGeneric struct {
+	Type          types.String `tfsdk:"type"`
+	Json          types.String `tfsdk:"json"`
+	SensitiveKeys types.Set    `tfsdk:"sensitive_keys"`
+}
+
+func GenericAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":           types.StringType,
+		"json":           types.StringType,
+		"sensitive_keys": types.SetType{ElemType: types.StringType},
+	}
+}
+
 type notificationSettingsAccessor struct {
 	/// Translates the TF type notification model into the JSON client model
 	Get func(m *notificationSettings, ctx context.Context, diags *diag.Diagnostics) any
@@ -364,7 +421,7 @@ var settingsAccessors = map[string]notificationSettingsAccessor{
 					EmailAddressAttributeTypes(),
 					notificationSettingsEmailAddress{
 						Id:    types.StringPointerValue(ss.Id),
-						Email: types.StringValue(ss.Email),
+						Email: stringValueOrNull(ss.Email),
 					},
 				)
 				if d.HasError() {
@@ -688,6 +745,12 @@ var settingsAccessors = map[string]notificationSettingsAccessor{
 				diags.Append(d...)
 				return nil
 			}
+			var headers map[string]string
+			d = webhook.Headers.ElementsAs(ctx, &headers, false)
+			if d.HasError() {
+				diags.Append(d...)
+				return nil
+			}
 			return clientWebhook{
 				Url:             webhook.Url.ValueString(),
 				Method:          webhook.Method.ValueString(),
@@ -696,6 +759,9 @@ var settingsAccessors = map[string]notificationSettingsAccessor{
 				AuthPassword:    webhook.AuthPassword.ValueString(),
 				AuthHeaderName:  webhook.AuthHeaderName.ValueString(),
 				AuthHeaderValue: webhook.AuthHeaderValue.ValueString(),
+				ContentType:     webhook.ContentType.ValueString(),
+				Headers:         headers,
+				PayloadTemplate: webhook.PayloadTemplate.ValueString(),
 			}
 		},
 		Set: func(m *notificationSettings, settings any, ctx context.Context, diags *diag.Diagnostics) {
@@ -721,6 +787,10 @@ var settingsAccessors = map[string]notificationSettingsAccessor{
 				webhook.AuthType = types.StringValue(settingsStruct.AuthType)
 				webhook.AuthUsername = types.StringValue(settingsStruct.AuthUsername)
 				webhook.AuthHeaderName = types.StringValue(settingsStruct.AuthHeaderName)
+				webhook.setTemplateSettings(settingsStruct, ctx, diags)
+				if diags.HasError() {
+					return
+				}
 
 				tfObject, d := types.ObjectValueFrom(ctx, WebhookAttributeTypes(), webhook)
 				if d.HasError() {
@@ -730,7 +800,22 @@ var settingsAccessors = map[string]notificationSettingsAccessor{
 				m.Webhook = tfObject
 
 			} else {
-				tfObject, d := types.ObjectValueFrom(ctx, WebhookAttributeTypes(), settingsStruct)
+				webhook := notificationSettingsWebhook{
+					Url:             types.StringValue(settingsStruct.Url),
+					Method:          types.StringValue(settingsStruct.Method),
+					AuthType:        types.StringValue(settingsStruct.AuthType),
+					AuthUsername:    types.StringValue(settingsStruct.AuthUsername),
+					AuthPassword:    types.StringValue(settingsStruct.AuthPassword),
+					AuthHeaderName:  types.StringValue(settingsStruct.AuthHeaderName),
+					AuthHeaderValue: types.StringValue(settingsStruct.AuthHeaderValue),
+					ContentType:     stringValueOrNull(settingsStruct.ContentType),
+				}
+				webhook.setTemplateSettings(settingsStruct, ctx, diags)
+				if diags.HasError() {
+					return
+				}
+
+				tfObject, d := types.ObjectValueFrom(ctx, WebhookAttributeTypes(), webhook)
 				if d.HasError() {
 					diags.Append(d...)
 					return
@@ -767,6 +852,111 @@ var settingsAccessors = map[string]notificationSettingsAccessor{
 	},
 }
 
+// genericSettingsAccessor returns the accessor for settings given as raw JSON. The values of the sensitive
+// keys are write-only: the values in the state are kept, whatever the server returns for them.
+func genericSettingsAccessor(notificationType string) notificationSettingsAccessor {
+	return notificationSettingsAccessor{
+		Get: func(m *notificationSettings, ctx context.Context, diags *diag.Diagnostics) any {
+			if m.Generic.IsNull() {
+				diags.AddError("Unsupported Notification Type Error",
+					fmt.Sprintf("%s: %s. Use 'settings.generic' to configure it.", errUnsupportedNotificationType, notificationType))
+				return nil
+			}
+			var generic notificationSettingsGeneric
+			d := m.Generic.As(ctx, &generic, basetypes.ObjectAsOptions{})
+			if d.HasError() {
+				diags.Append(d...)
+				return nil
+			}
+			if generic.Type.ValueString() != notificationType {
+				diags.AddAttributeError(path.Root("settings").AtName("generic").AtName("type"), "Invalid Generic Settings",
+					fmt.Sprintf("The generic settings type '%s' must match the notification type '%s'.",
+						generic.Type.ValueString(), notificationType))
+				return nil
+			}
+
+			var settings map[string]any
+			if err := json.Unmarshal([]byte(generic.Json.ValueString()), &settings); err != nil {
+				diags.AddError("Marshal Error",
+					fmt.Sprintf("Error unmarshalling 'generic' settings: %s", err))
+				return nil
+			}
+			return settings
+		},
+		Set: func(m *notificationSettings, settings any, ctx context.Context, diags *diag.Diagnostics) {
+			settingsMap, err := toSettingsStruct[map[string]any](settings)
+			if err != nil {
+				diags.AddError("Marshal Error",
+					fmt.Sprintf("Error marshalling 'generic' settings: %s", err))
+				return
+			}
+			if *settingsMap == nil {
+				*settingsMap = map[string]any{}
+			}
+
+			generic := notificationSettingsGeneric{
+				Type:          types.StringValue(notificationType),
+				SensitiveKeys: types.SetNull(types.StringType),
+			}
+			if !m.Generic.IsNull() {
+				d := m.Generic.As(ctx, &generic, basetypes.ObjectAsOptions{})
+				if d.HasError() {
+					diags.Append(d...)
+					return
+				}
+				restoreSensitiveSettings(*settingsMap, generic, ctx, diags)
+				if diags.HasError() {
+					return
+				}
+			}
+
+			// The keys of a map are sorted when marshalled, matching the standardized JSON in the plan.
+			data, err := json.Marshal(*settingsMap)
+			if err != nil {
+				diags.AddError("Marshal Error",
+					fmt.Sprintf("Error marshalling 'generic' settings: %s", err))
+				return
+			}
+			generic.Json = types.StringValue(string(data))
+
+			tfObject, d := types.ObjectValueFrom(ctx, GenericAttributeTypes(), generic)
+			if d.HasError() {
+				diags.Append(d...)
+				return
+			}
+			m.Generic = tfObject
+		},
+	}
+}
+
+// restoreSensitiveSettings replaces the values of the sensitive keys with the values in the current settings.
+func restoreSensitiveSettings(settings map[string]any, current notificationSettingsGeneric, ctx context.Context, diags *diag.Diagnostics) {
+	var sensitiveKeys []string
+	d := current.SensitiveKeys.ElementsAs(ctx, &sensitiveKeys, false)
+	if d.HasError() {
+		diags.Append(d...)
+		return
+	}
+	if len(sensitiveKeys) == 0 {
+		return
+	}
+
+	var currentSettings map[string]any
+	if err := json.Unmarshal([]byte(current.Json.ValueString()), &currentSettings); err != nil {
+		diags.AddError("Marshal Error",
+			fmt.Sprintf("Error unmarshalling 'generic' settings: %s", err))
+		return
+	}
+
+	for _, key := range sensitiveKeys {
+		if value, found := currentSettings[key]; found {
+			settings[key] = value
+		} else {
+			delete(settings, key)
+		}
+	}
+}
+
 // Utility function to marshal anonymous JSON to concrete models defined by T.
 func toSettingsStruct[T any](settings any) (*T, error) {
 	data, err := json.Marshal(settings)
@@ -783,9 +973,34 @@ func toSettingsStruct[T any](settings any) (*T, error) {
 	return &concreteSettings, nil
 }
 
-func (m *notificationResourceModel) SetSettings(clientSettings *any, ctx context.Context, diags *diag.Diagnostics) {
+// settingsAccessor returns the accessor for the settings of the notification. The generic accessor is
+// used when the generic settings are configured, and for the types that the provider doesn't support.
+func (m *notificationResourceModel) settingsAccessor(ctx context.Context, diags *diag.Diagnostics) (notificationSettingsAccessor, bool) {
+	if !m.Settings.IsNull() && !m.Settings.IsUnknown() {
+		var settings notificationSettings
+		d := m.Settings.As(ctx, &settings, basetypes.ObjectAsOptions{})
+		if d.HasError() {
+			diags.Append(d...)
+			return notificationSettingsAccessor{}, false
+		}
+		if !settings.Generic.IsNull() {
+			return genericSettingsAccessor(m.Type.ValueString()), true
+		}
+	}
 
 	if accessor, found := settingsAccessors[m.Type.ValueString()]; found {
+		return accessor, true
+	}
+	if m.Settings.IsNull() {
+		// Imported notifications of types without dedicated settings.
+		return genericSettingsAccessor(m.Type.ValueString()), true
+	}
+	return notificationSettingsAccessor{}, false
+}
+
+func (m *notificationResourceModel) SetSettings(clientSettings *any, ctx context.Context, diags *diag.Diagnostics) {
+
+	if accessor, found := m.settingsAccessor(ctx, diags); found {
 		if m.Settings.IsNull() {
 			var model = notificationSettings{
 				Email:                 types.ObjectNull(EmailAttributeTypes()),
@@ -799,6 +1014,7 @@ func (m *notificationResourceModel) SetSettings(clientSettings *any, ctx context
 				Pushover:              types.ObjectNull(PushoverAttributeTypes()),
 				SolarWindsServiceDesk: types.ObjectNull(SolarWindsServiceDeskAttributeTypes()),
 				ServiceNow:            types.ObjectNull(ServiceNowAttributeTypes()),
+				Generic:               types.ObjectNull(GenericAttributeTypes()),
 			}
 
 			accessor.Set(&model, clientSettings, ctx, diags)
@@ -840,7 +1056,7 @@ func (m *notificationResourceModel) SetSettings(clientSettings *any, ctx context
 
 func (m *notificationResourceModel) GetSettings(ctx context.Context, diags *diag.Diagnostics) any {
 
-	if accessor, found := settingsAccessors[m.Type.ValueString()]; found {
+	if accessor, found := m.settingsAccessor(ctx, diags); found {
 		if m.Settings.IsNull() {
 			m.Settings = types.ObjectNull(NotificationSettingsAttributeTypes())
 			return nil
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRestoreSensitiveSettings(t *testing.T) {
+	ctx := context.Background()
+	current := notificationSettingsGeneric{
+		Type: types.StringValue("newIntegration"),
+		Json: types.StringValue(`{"token":"secret","url":"https://example.com"}`),
+		SensitiveKeys: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("token"),
+			types.StringValue("password"),
+		}),
+	}
+	settings := map[string]any{
+		"token":    "********",
+		"password": "********",
+		"url":      "https://example.com/new",
+	}
+
+	var diags diag.Diagnostics
+	restoreSensitiveSettings(settings, current, ctx, &diags)
+	if diags.HasError() {
+		t.Fatalf("restoreSensitiveSettings() returned errors: %v", diags)
+	}
+
+	if settings["token"] != "secret" {
+		t.Errorf("token = %v, want the value from the current settings", settings["token"])
+	}
+	if _, found := settings["password"]; found {
+		t.Errorf("password should be removed when it isn't in the current settings")
+	}
+	if settings["url"] != "https://example.com/new" {
+		t.Errorf("url = %v, non-sensitive keys should keep the value read from SWO", settings["url"])
+	}
+}
@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,9 +23,36 @@ func NewNotificationResource() resource.Resource {
 	return &notificationResource{}
 }
 
+const testNotificationMutation = `mutation testNotification($input: TestNotificationServiceConfigurationInput!) {
+  testNotificationServiceConfiguration(input: $input) {
+    code
+    success
+    message
+  }
+}`
+
+const listNotificationsQuery = `query listNotifications($filter: NotificationServiceFilter) {
+  user {
+    currentOrganization {
+      notificationServices(filter: $filter) {
+        id
+        type
+        title
+      }
+    }
+  }
+}`
+
+var (
+	ErrNotificationNotFound  = errors.New("notification not found")
+	ErrNotificationAmbiguous = errors.New("notification title is ambiguous")
+	ErrUserNotFound          = errors.New("user not found")
+)
+
 // Defines the resource implementation.
 type notificationResource struct {
-	client *swoClient.Client
+	client    *swoClient.Client
+	gqlClient *gqlClient
 }
 
 func (r *notificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -32,6 +62,95 @@ func (r *notificationResource) Metadata(ctx context.Context, req resource.Metada
 func (r *notificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, _ := req.ProviderData.(providerClients)
 	r.client = client.SwoClient
+	r.gqlClient = client.GqlClient
+}
+
+// sendTestNotification asks the server to deliver a test notification using the given settings.
+// An error is returned when the delivery fails.
+func (r *notificationResource) sendTestNotification(ctx context.Context, id string, plan notificationResourceModel, settings any) error {
+	type testNotificationResponse struct {
+		TestNotificationServiceConfiguration gqlMutationResponse `json:"testNotificationServiceConfiguration"`
+	}
+
+	resp, err := gqlDo[testNotificationResponse](ctx, r.gqlClient, "testNotification", testNotificationMutation,
+		map[string]any{
+			"input": map[string]any{
+				"id":          id,
+				"type":        plan.Type.ValueString(),
+				"description": plan.Description.ValueStringPointer(),
+				"settings":    settings,
+			},
+		})
+	if err != nil {
+		return err
+	}
+
+	return resp.TestNotificationServiceConfiguration.err("test notification failed")
+}
+
+// resolveEmailAddresses fills in the current email address of every address that only references a user id.
+func (r *notificationResource) resolveEmailAddresses(ctx context.Context, settings any) (any, error) {
+	email, ok := settings.(clientEmail)
+	if !ok || len(emailAddressUserIds(email)) == 0 {
+		return settings, nil
+	}
+
+	emails, err := userEmailsById(ctx, r.gqlClient)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]clientEmailAddress, len(email.Addresses))
+	for i, address := range email.Addresses {
+		if address.Email == "" && address.Id != nil {
+			userEmail, found := emails[*address.Id]
+			if !found {
+				return nil, fmt.Errorf("%w: %s", ErrUserNotFound, *address.Id)
+			}
+			address.Email = userEmail
+		}
+		addresses[i] = address
+	}
+	return clientEmail{Addresses: addresses}, nil
+}
+
+// syncEmailAddresses clears the email of the addresses that were configured by user id only, as long as the
+// server still holds the current email address of the user. A stale email is kept so the difference is planned
+// as an update, which then sends the current address.
+func (r *notificationResource) syncEmailAddresses(ctx context.Context, settings *any, userIds map[string]bool) (*any, error) {
+	if settings == nil || len(userIds) == 0 {
+		return settings, nil
+	}
+
+	email, err := toSettingsStruct[clientEmail](*settings)
+	if err != nil {
+		return nil, err
+	}
+
+	emails, err := userEmailsById(ctx, r.gqlClient)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, address := range email.Addresses {
+		if address.Id != nil && userIds[*address.Id] && emails[*address.Id] == address.Email {
+			email.Addresses[i].Email = ""
+		}
+	}
+
+	var synced any = *email
+	return &synced, nil
+}
+
+// emailAddressUserIds returns the user ids of the addresses without an email.
+func emailAddressUserIds(email clientEmail) map[string]bool {
+	userIds := map[string]bool{}
+	for _, address := range email.Addresses {
+		if address.Email == "" && address.Id != nil {
+			userIds[*address.Id] = true
+		}
+	}
+	return userIds
 }
 
 func (r *notificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -46,6 +165,12 @@ func (r *notificationResource) Create(ctx context.Context, req resource.CreateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	tfSettings, err := r.resolveEmailAddresses(ctx, tfSettings)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error resolving email addresses of notification '%s'. error: %s", tfPlan.Title, err))
+		return
+	}
 	input := swoClient.CreateNotificationInput{
 		Title:       tfPlan.Title.ValueString(),
 		Description: tfPlan.Description.ValueStringPointer(),
@@ -66,6 +191,17 @@ func (r *notificationResource) Create(ctx context.Context, req resource.CreateRe
 	// https://developer.hashicorp.com/terraform/plugin/framework/resources/import#multiple-attributes
 	tfPlan.Id = types.StringValue(fmt.Sprintf("%s:%s", newNotification.Id, newNotification.Type))
 	resp.Diagnostics.Append(resp.State.Set(ctx, tfPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The state is already saved, so a failed verification taints the new notification.
+	if tfPlan.VerifyOnApply.ValueBool() {
+		if err = r.sendTestNotification(ctx, newNotification.Id, tfPlan, tfSettings); err != nil {
+			resp.Diagnostics.AddError("Notification Verification Error",
+				fmt.Sprintf("error sending test notification '%s'. error: %s", tfPlan.Title, err))
+		}
+	}
 }
 
 func (r *notificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -82,6 +218,15 @@ func (r *notificationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	// Addresses configured by user id only are kept in sync with the user's current email.
+	var userIds map[string]bool
+	if email, ok := tfState.GetSettings(ctx, &resp.Diagnostics).(clientEmail); ok {
+		userIds = emailAddressUserIds(email)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Read the notification...
 	notification, err := r.client.NotificationsService().Read(ctx, nId, nType)
 	if err != nil {
@@ -90,11 +235,18 @@ func (r *notificationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	settings, err := r.syncEmailAddresses(ctx, notification.Settings, userIds)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error syncing email addresses of notification %s. error: %s", nId, err))
+		return
+	}
+
 	tfState.Id = types.StringValue(fmt.Sprintf("%s:%s", notification.Id, notification.Type))
 	tfState.Title = types.StringValue(notification.Title)
 	tfState.Type = types.StringValue(notification.Type)
 	tfState.Description = types.StringPointerValue(notification.Description)
-	tfState.SetSettings(notification.Settings, ctx, &resp.Diagnostics)
+	tfState.SetSettings(settings, ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -120,6 +272,12 @@ func (r *notificationResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	tfSettings, err = r.resolveEmailAddresses(ctx, tfSettings)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error resolving email addresses of notification %s. err: %s", nId, err))
+		return
+	}
 	// Update the notification...
 	_, err = r.client.NotificationsService().Update(ctx,
 		swoClient.UpdateNotificationInput{
@@ -137,6 +295,16 @@ func (r *notificationResource) Update(ctx context.Context, req resource.UpdateRe
 
 	// Save to Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &tfPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if tfPlan.VerifyOnApply.ValueBool() {
+		if err = r.sendTestNotification(ctx, nId, tfPlan, tfSettings); err != nil {
+			resp.Diagnostics.AddError("Notification Verification Error",
+				fmt.Sprintf("error sending test notification %s. err: %s", nId, err))
+		}
+	}
 }
 
 func (r *notificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -161,6 +329,64 @@ func (r *notificationResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
+// findNotificationByTitle looks up the notification with the given title, optionally restricted to a
+// notification type. It fails when no notification, or more than one, has the title.
+func (r *notificationResource) findNotificationByTitle(ctx context.Context, notificationType string, title string) (string, error) {
+	type notificationService struct {
+		Id    string `json:"id"`
+		Type  string `json:"type"`
+		Title string `json:"title"`
+	}
+	type listNotificationsResponse struct {
+		User struct {
+			CurrentOrganization struct {
+				NotificationServices []notificationService `json:"notificationServices"`
+			} `json:"currentOrganization"`
+		} `json:"user"`
+	}
+
+	var filter map[string]any
+	if notificationType != "" {
+		filter = map[string]any{"type": notificationType}
+	}
+
+	resp, err := gqlDo[listNotificationsResponse](ctx, r.gqlClient, "listNotifications", listNotificationsQuery,
+		map[string]any{"filter": filter})
+	if err != nil {
+		return "", err
+	}
+
+	var matches []string
+	for _, n := range resp.User.CurrentOrganization.NotificationServices {
+		if n.Title == title {
+			matches = append(matches, fmt.Sprintf("%s:%s", n.Id, n.Type))
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("%w: %q", ErrNotificationNotFound, title)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%w: %q matches [%s]. import using one of the `{id}:{type}` identifiers instead",
+			ErrNotificationAmbiguous, title, strings.Join(matches, ", "))
+	}
+}
+
 func (r *notificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	nId, nType, title := ParseNotificationImportId(req.ID)
+	if title == "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s:%s", nId, nType))...)
+		return
+	}
+
+	id, err := r.findNotificationByTitle(ctx, nType, title)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error",
+			fmt.Sprintf("error importing notification %q. error: %s", req.ID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
@@ -509,6 +509,59 @@ func testAccWebhookConfig(title string) string {
 	}`, title)
 }
 
+func TestAccWebhookTemplateNotificationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebhookTemplateConfig("test-acc test one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("swo_notification.test_webhook_template", "settings.webhook.method", "POST"),
+					resource.TestCheckResourceAttr("swo_notification.test_webhook_template", "settings.webhook.content_type", "application/json"),
+					resource.TestCheckResourceAttr("swo_notification.test_webhook_template", "settings.webhook.headers.X-Env", "test"),
+					resource.TestCheckResourceAttr("swo_notification.test_webhook_template", "settings.webhook.payload_template",
+						"{\n  \"text\": \"{{alert.name}} is {{alert.status}}\",\n  \"value\": {{condition.value}}\n}\n"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccWebhookTemplateConfig("test-acc test two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("swo_notification.test_webhook_template", "title", "test-acc test two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+func testAccWebhookTemplateConfig(title string) string {
+	return providerConfig() + fmt.Sprintf(`
+	resource "swo_notification" "test_webhook_template" {
+  		title       = %[1]q
+  		description = "testing..."
+  		type        = "webhook"
+  		settings = {
+    		webhook = {
+      			method       = "POST"
+				url          = "https://webhook.example.com/"
+				content_type = "application/json"
+				headers = {
+					"X-Env" = "test"
+				}
+				payload_template = <<-EOT
+				{
+				  "text": "{{alert.name}} is {{alert.status}}",
+				  "value": {{condition.value}}
+				}
+				EOT
+			}
+		}
+	}`, title)
+}
+
 func TestAccZapierNotificationResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -553,3 +606,77 @@ func testAccZapierConfig(title string) string {
 		}
 	}`, title)
 }
+
+func TestAccGenericNotificationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGenericConfig("test-acc test one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("swo_notification.test_generic", "type", "slack"),
+					resource.TestCheckResourceAttr("swo_notification.test_generic", "settings.generic.type", "slack"),
+					resource.TestCheckResourceAttr("swo_notification.test_generic", "settings.generic.json",
+						`{"url":"https://hooks.slack.com/services/XXX/XXX/XXX"}`),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccGenericConfig("test-acc test two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("swo_notification.test_generic", "title", "test-acc test two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGenericConfig(title string) string {
+	return providerConfig() + fmt.Sprintf(`
+	resource "swo_notification" "test_generic" {
+  		title       = %[1]q
+  		description = "testing..."
+  		type        = "slack"
+  		settings = {
+    		generic = {
+      			type           = "slack"
+      			json           = jsonencode({ url = "https://hooks.slack.com/services/XXX/XXX/XXX" })
+      			sensitive_keys = ["url"]
+    		}
+		}
+	}`, title)
+}
+
+func TestParseNotificationImportId(t *testing.T) {
+	tests := []struct {
+		name      string
+		importId  string
+		wantId    string
+		wantType  string
+		wantTitle string
+	}{
+		{name: "id and type", importId: "123:slack", wantId: "123", wantType: "slack"},
+		{name: "id and type casing", importId: "123:msteams", wantId: "123", wantType: "msTeams"},
+		{name: "id and type without settings", importId: "123:newrelic", wantId: "123", wantType: "newRelic"},
+		{name: "type and title", importId: "webhook/On-call hook", wantType: "webhook", wantTitle: "On-call hook"},
+		{name: "title", importId: "On-call hook", wantTitle: "On-call hook"},
+		{name: "title with colon", importId: "Team: payments", wantTitle: "Team: payments"},
+		{name: "title with colon and no space", importId: "Team:payments", wantTitle: "Team:payments"},
+		{name: "title with colon and type", importId: "slack/Team:payments", wantType: "slack", wantTitle: "Team:payments"},
+		{name: "title with slash", importId: "payments/oncall", wantTitle: "payments/oncall"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, nType, title := ParseNotificationImportId(tt.importId)
+			if id != tt.wantId || nType != tt.wantType || title != tt.wantTitle {
+				t.Errorf("ParseNotificationImportId(%q) = (%q, %q, %q), want (%q, %q, %q)",
+					tt.importId, id, nType, title, tt.wantId, tt.wantType, tt.wantTitle)
+			}
+		})
+	}
+}
@@ -12,10 +12,12 @@ import (
 	"github.com/solarwinds/terraform-provider-swo/internal/planmodifier/stringmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/solarwinds/terraform-provider-swo/internal/typex"
 	"github.com/solarwinds/terraform-provider-swo/internal/validators"
 )
 
@@ -39,11 +41,12 @@ func newParseError(msg string) error {
 
 // The main Notification Resource model that is derived from the schema.
 type notificationResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Title       types.String `tfsdk:"title"`
-	Description types.String `tfsdk:"description"`
-	Type        types.String `tfsdk:"type"`
-	Settings    types.Object `tfsdk:"settings"`
+	Id            types.String `tfsdk:"id"`
+	Title         types.String `tfsdk:"title"`
+	Description   types.String `tfsdk:"description"`
+	Type          types.String `tfsdk:"type"`
+	Settings      types.Object `tfsdk:"settings"`
+	VerifyOnApply types.Bool   `tfsdk:"verify_on_apply"`
 }
 
 func ParseNotificationId(id types.String) (idValue string, notificationType string, err error) {
@@ -57,13 +60,49 @@ func ParseNotificationId(id types.String) (idValue string, notificationType stri
 	return idValue, notificationType, err
 }
 
+// ParseNotificationImportId splits an import identifier into its parts. The supported formats are
+// `{id}:{type}`, `{type}/{title}` and `{title}`. Either the id or the title is returned, the type is
+// empty when the identifier doesn't include one. An identifier is only split by its type when the type is
+// a known notification type, so titles with a colon or a slash are kept as titles. Types without dedicated
+// settings are managed with the generic settings.
+func ParseNotificationImportId(importId string) (idValue string, notificationType string, title string) {
+	if nId, nType, err := ParseNotificationId(types.StringValue(importId)); err == nil {
+		if canonicalType, found := canonicalNotificationType(nType); found {
+			return nId, canonicalType, ""
+		}
+	}
+
+	if nType, nTitle, found := strings.Cut(importId, "/"); found && nTitle != "" {
+		if canonicalType, found := canonicalNotificationType(nType); found {
+			return "", canonicalType, nTitle
+		}
+	}
+
+	return "", "", importId
+}
+
+// canonicalNotificationType returns the notification type with the casing used by the API. The known types are
+// the types with dedicated settings and the notification action types of alerts.
+func canonicalNotificationType(notificationType string) (string, bool) {
+	for t := range settingsAccessors {
+		if strings.EqualFold(t, notificationType) {
+			return t, true
+		}
+	}
+	if t := canonicalNotificationActionTypes[strings.ToLower(notificationType)]; t != "" {
+		return t, true
+	}
+	return "", false
+}
+
 func (r *notificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A terraform resource for managing notifications.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The Id of the resource provided by the backend in the format of `{id}:{type}`.",
-				Computed:    true,
+				Description: "The Id of the resource provided by the backend in the format of `{id}:{type}`. " +
+					"Imports also accept `{type}/{title}` or `{title}` when the title is unique.",
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringmodifier.UseNonNullStateForUnknown(),
 				},
@@ -80,6 +119,12 @@ func (r *notificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "Notification type (email, slack, etc).",
 				Required:    true,
 			},
+			"verify_on_apply": schema.BoolAttribute{
+				Description: "When true, a test notification is sent after the notification is created or updated. " +
+					"A failed delivery is reported as an apply error, and a newly created notification is marked as tainted. " +
+					"Default is `false`.",
+				Optional: true,
+			},
 			"settings": schema.SingleNestedAttribute{
 				Description: "The notification settings.",
 				Required:    true,
@@ -94,13 +139,15 @@ func (r *notificationResource) Schema(_ context.Context, _ resource.SchemaReques
 								NestedObject: schema.NestedAttributeObject{
 									Attributes: map[string]schema.Attribute{
 										"id": schema.StringAttribute{
-											Description: "The user id associated to the email address.",
-											Optional:    true,
+											Description: "The user id associated to the email address. Use the `swo_users` data source to look up user ids. " +
+												"When `email` is omitted the current email address of the user is used and kept in sync.",
+											Optional: true,
 										},
 										"email": schema.StringAttribute{
-											Description: "The email address.",
-											Required:    true,
+											Description: "The email address. Required unless `id` is set.",
+											Optional:    true,
 											Validators: []validator.String{
+												stringvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("id")),
 												stringvalidator.RegexMatches(
 													regexp.MustCompile(emailRegex),
 													"Requirement: "+emailRegex,
@@ -211,6 +258,31 @@ func (r *notificationResource) Schema(_ context.Context, _ resource.SchemaReques
 									stringplanmodifier.RequiresReplace(),
 								},
 							},
+							"content_type": schema.StringAttribute{
+								Description: "Content type of the webhook request body. `application/json` is used when not set. " +
+									"Valid values are [`application/json`|`application/x-www-form-urlencoded`|`text/plain`].",
+								Optional: true,
+								Validators: []validator.String{
+									validators.OneOf(webhookContentTypeJson, webhookContentTypeForm, webhookContentTypeText),
+								},
+							},
+							"headers": schema.MapAttribute{
+								Description: "Static headers added to every webhook request.",
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+							"payload_template": schema.StringAttribute{
+								Description: "Template for the webhook request body. Alert variables are referenced as `{{variable}}`. " +
+									"The documented variables are [" + strings.Join(typex.Map(webhookTemplateVariables,
+									func(v string) string { return fmt.Sprintf("`%s`", v) }), "|") + "], other variables " +
+									"are reported with a warning. The template must render to valid JSON when the content type " +
+									"is `application/json`, and changes in whitespace aren't shown as changes after the apply.",
+								Optional:   true,
+								CustomType: webhookTemplateType{},
+								Validators: []validator.String{
+									webhookTemplateValidator{},
+								},
+							},
 						},
 					},
 					"opsgenie": schema.SingleNestedAttribute{
@@ -357,6 +429,31 @@ func (r *notificationResource) Schema(_ context.Context, _ resource.SchemaReques
 							},
 						},
 					},
+					"generic": schema.SingleNestedAttribute{
+						Description: "Raw settings for notification types that the provider doesn't support yet. " +
+							"Can be used with any notification type.",
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Description: "The notification type. Must match the `type` of the notification.",
+								Required:    true,
+							},
+							"json": schema.StringAttribute{
+								Description: "The settings as a JSON object, as expected by the SWO API. Use `jsonencode` to build it.",
+								Required:    true,
+								Sensitive:   true,
+								PlanModifiers: []planmodifier.String{
+									useStandarizedJson(),
+								},
+							},
+							"sensitive_keys": schema.SetAttribute{
+								Description: "Top-level keys of `json` whose values are write-only. Their values are " +
+									"sent to SWO, but never read back, so secrets that SWO masks don't cause drift.",
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+						},
+					},
 				},
 			},
 		},
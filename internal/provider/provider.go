@@ -15,13 +15,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	swoClient "github.com/solarwinds/swo-client-go/pkg/client"
 	"github.com/solarwinds/swo-sdk-go/swov1"
 	"github.com/solarwinds/terraform-provider-swo/internal/envvar"
 )
 
 var (
-	dataSources            []func() datasource.DataSource
 	ErrNonMatchingEntities = errors.New("updated entity properties don't match")
 	ErrMarshal             = errors.New("error during marshalling")
 )
@@ -30,13 +30,21 @@ var resources = []func() resource.Resource{
 	NewAlertResource,
 	NewApiTokenResource,
 	NewCompositeMetricResource,
+	NewDashboardCategoryResource,
 	NewDashboardResource,
 	NewLogFilterResource,
 	NewNotificationResource,
+	NewTransactionCheckResource,
 	NewUriResource,
 	NewWebsiteResource,
 }
 
+var dataSources = []func() datasource.DataSource{
+	NewUsersDataSource,
+	NewDashboardDataSource,
+	NewDashboardCategoryDataSource,
+}
+
 const (
 	expBackoffMaxInterval = 30 * time.Second
 	expBackoffMaxElapsed  = 2 * time.Minute
@@ -65,11 +73,25 @@ type swoProviderModel struct {
 	RequestTimeout types.Int64  `tfsdk:"request_timeout"`
 	BaseURL        types.String `tfsdk:"base_url"`
 	DebugMode      types.Bool   `tfsdk:"debug_mode"`
+	DefaultTags    types.Object `tfsdk:"default_tags"`
+	IgnoreTags     types.Object `tfsdk:"ignore_tags"`
+}
+
+type swoProviderDefaultTagsModel struct {
+	Tags map[string]string `tfsdk:"tags"`
+}
+
+type swoProviderIgnoreTagsModel struct {
+	Keys        []string `tfsdk:"keys"`
+	KeyPrefixes []string `tfsdk:"key_prefixes"`
 }
 
 type providerClients struct {
 	SwoClient   *swoClient.Client
 	SwoV1Client *swov1.Swo
+	GqlClient   *gqlClient
+	// The default tags and ignored tags of taggable entities.
+	EntityTags *entityTagsConfig
 }
 
 func (p *swoProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -97,6 +119,37 @@ func (p *swoProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 				Description: "Setting to true will provide additional logging details.",
 				Optional:    true,
 			},
+			"default_tags": schema.SingleNestedAttribute{
+				Description: "Tags that are added to every resource that has tags, e.g. `swo_website` and `swo_uri`. " +
+					"The tags of a resource override the default tags with the same key. The `tags_all` attribute " +
+					"of a resource has its tags merged with the default tags.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						Description: "The default tags by key.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"ignore_tags": schema.SingleNestedAttribute{
+				Description: "Tags that are managed outside terraform, e.g. by SWO automation. Ignored tags aren't " +
+					"read into the state of a resource, so they don't show up as changes, and they are kept when a " +
+					"resource is updated. Tags of a resource configuration shouldn't use ignored keys.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"keys": schema.SetAttribute{
+						Description: "The keys of the ignored tags.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"key_prefixes": schema.SetAttribute{
+						Description: "The key prefixes of the ignored tags.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
 		},
 	}
 }
@@ -143,15 +196,52 @@ func (p *swoProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		swov1.WithClient(&http.Client{Timeout: clientTimeout}),
 	)
 
+	gqlTransport := p.transport
+	if gqlTransport == nil {
+		gqlTransport = &bearerTokenTransport{
+			apiToken: config.ApiToken.ValueString(),
+			base:     http.DefaultTransport,
+		}
+	}
+
+	gqlClient := newGqlClient(config.BaseURL.ValueString(), &http.Client{
+		Timeout:   clientTimeout,
+		Transport: gqlTransport,
+	})
+
+	entityTags := p.entityTagsConfig(ctx, model, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	providerClients := providerClients{
 		SwoClient:   client,
 		SwoV1Client: swoV1Client,
+		GqlClient:   gqlClient,
+		EntityTags:  entityTags,
 	}
 
 	resp.DataSourceData = providerClients
 	resp.ResourceData = providerClients
 }
 
+// entityTagsConfig returns the tag configuration of the default tags and ignored tags of the provider.
+func (p *swoProvider) entityTagsConfig(ctx context.Context, model swoProviderModel, resp *provider.ConfigureResponse) *entityTagsConfig {
+	config := &entityTagsConfig{}
+	if !model.DefaultTags.IsNull() {
+		var defaultTags swoProviderDefaultTagsModel
+		resp.Diagnostics.Append(model.DefaultTags.As(ctx, &defaultTags, basetypes.ObjectAsOptions{})...)
+		config.DefaultTags = defaultTags.Tags
+	}
+	if !model.IgnoreTags.IsNull() {
+		var ignoreTags swoProviderIgnoreTagsModel
+		resp.Diagnostics.Append(model.IgnoreTags.As(ctx, &ignoreTags, basetypes.ObjectAsOptions{})...)
+		config.IgnoreKeys = ignoreTags.Keys
+		config.IgnoreKeyPrefixes = ignoreTags.KeyPrefixes
+	}
+	return config
+}
+
 func (p *swoProvider) ConfigureClientVars(config swoProviderModel, resp *provider.ConfigureResponse) (*swoProviderModel, bool) {
 	if config.ApiToken.ValueString() == "" {
 		apiToken, exists := os.LookupEnv(apiTokenEnv)
@@ -210,10 +300,15 @@ func New(version string, transport http.RoundTripper) func() provider.Provider {
 }
 
 func BackoffRetry[T any](ctx context.Context, operation backoff.Operation[T]) (T, error) {
+	return BackoffRetryWithTimeout(ctx, expBackoffMaxElapsed, operation)
+}
+
+// BackoffRetryWithTimeout retries the operation with exponential backoff until it succeeds, or the timeout elapses.
+func BackoffRetryWithTimeout[T any](ctx context.Context, timeout time.Duration, operation backoff.Operation[T]) (T, error) {
 	expBackoff := backoff.NewExponentialBackOff()
 	expBackoff.MaxInterval = expBackoffMaxInterval
 
-	return backoff.Retry(ctx, operation, backoff.WithBackOff(expBackoff), backoff.WithMaxElapsedTime(expBackoffMaxElapsed))
+	return backoff.Retry(ctx, operation, backoff.WithBackOff(expBackoff), backoff.WithMaxElapsedTime(timeout))
 }
 
 func ReadRetry[T any](ctx context.Context, id string, operation ReadOperation[T]) (T, error) {
@@ -2,11 +2,22 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
+// createUnconfirmedKey is the private state key that marks an entity whose create wasn't confirmed.
+const createUnconfirmedKey = "create_unconfirmed"
+
+var ErrCreateNotConfirmed = errors.New("the create of the entity is not confirmed")
+
 var (
 	_ resource.ResourceWithConfigure        = &resourceWrapper{}
 	_ resource.ResourceWithImportState      = &resourceWrapper{}
@@ -58,7 +69,45 @@ func (r *resourceWrapper) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *resourceWrapper) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	(*r.innerResource).Read(ctx, req, resp)
+	unconfirmed, d := req.Private.GetKey(ctx, createUnconfirmedKey)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(unconfirmed) == 0 {
+		(*r.innerResource).Read(ctx, req, resp)
+		return
+	}
+
+	// The create of the entity wasn't confirmed, and creates are eventually consistent. Retry the read until the
+	// entity is returned, within the read timeout of the entity.
+	readTimeout := stateReadTimeout(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	attempt, err := BackoffRetryWithTimeout(ctx, readTimeout, func() (*resource.ReadResponse, error) {
+		attempt := &resource.ReadResponse{
+			State:    tfsdk.State{Schema: resp.State.Schema, Raw: resp.State.Raw.Copy()},
+			Identity: resp.Identity,
+			Private:  resp.Private,
+		}
+		(*r.innerResource).Read(ctx, req, attempt)
+		if attempt.Diagnostics.HasError() || attempt.State.Raw.IsNull() {
+			return attempt, ErrCreateNotConfirmed
+		}
+		return attempt, nil
+	})
+
+	resp.State = attempt.State
+	resp.Identity = attempt.Identity
+	resp.Deferred = attempt.Deferred
+	resp.Diagnostics.Append(attempt.Diagnostics...)
+	if err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, createUnconfirmedKey, nil)...)
+	}
 }
 
 func (r *resourceWrapper) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -106,3 +155,42 @@ func (r *resourceWrapper) ValidateConfig(ctx context.Context, req resource.Valid
 		v.ValidateConfig(ctx, req, resp)
 	}
 }
+
+// stateReadTimeout returns the read timeout of the 'timeouts' block of the state, or the default timeout when the
+// resource has no 'timeouts' block.
+func stateReadTimeout(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) time.Duration {
+	if _, d := state.Schema.TypeAtPath(ctx, path.Root("timeouts")); d.HasError() {
+		return expBackoffMaxElapsed
+	}
+
+	var tfTimeouts timeouts.Value
+	diags.Append(state.GetAttribute(ctx, path.Root("timeouts"), &tfTimeouts)...)
+	if diags.HasError() {
+		return expBackoffMaxElapsed
+	}
+	readTimeout, d := tfTimeouts.Read(ctx, expBackoffMaxElapsed)
+	diags.Append(d...)
+	return readTimeout
+}
+
+// createThenConfirm records the id of a created entity in state as soon as the entity exists, and then confirms the
+// create with confirm, which is expected to set the state of the entity. An entity whose confirmation fails keeps
+// its id in state along with the error, so terraform taints it instead of leaking it, and the next refresh retries
+// the confirmation read.
+func createThenConfirm(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, id string, confirm func()) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if _, d := req.Plan.Schema.TypeAtPath(ctx, path.Root("timeouts")); !d.HasError() {
+		// The timeouts are kept, so the next refresh reads with the configured timeout.
+		var tfTimeouts timeouts.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &tfTimeouts)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeouts"), tfTimeouts)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	confirm()
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, createUnconfirmedKey, []byte("true"))...)
+	}
+}
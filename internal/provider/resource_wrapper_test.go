@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// fakeConfirmResource creates an entity, and fails to read it back until it has been read failedReads times.
+type fakeConfirmResource struct {
+	failedReads int
+	reads       int
+}
+
+type fakeConfirmResourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *fakeConfirmResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "fake"
+}
+
+func (r *fakeConfirmResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   resourceIdAttribute(),
+			"name": schema.StringAttribute{Required: true},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Read: true}),
+		},
+	}
+}
+
+func (r *fakeConfirmResource) read(model *fakeConfirmResourceModel, diags *diag.Diagnostics) {
+	r.reads++
+	if r.reads <= r.failedReads {
+		diags.AddError("Client Error", "entity not found")
+		return
+	}
+	model.Name = types.StringValue("created")
+}
+
+func (r *fakeConfirmResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var tfPlan fakeConfirmResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &tfPlan)...)
+	createThenConfirm(ctx, req, resp, "fake-id", func() {
+		tfPlan.Id = types.StringValue("fake-id")
+		r.read(&tfPlan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, tfPlan)...)
+	})
+}
+
+func (r *fakeConfirmResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var tfState fakeConfirmResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &tfState)...)
+	r.read(&tfState, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, tfState)...)
+}
+
+func (r *fakeConfirmResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *fakeConfirmResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// testUnconfirmedCreate creates an entity of the fake resource whose confirmation fails, with the read timeout of
+// the 'timeouts' block, if any.
+func testUnconfirmedCreate(t *testing.T, r resource.Resource, readTimeout string) resource.CreateResponse {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	tfSchema := schemaResp.Schema
+	tfType := tfSchema.Type().TerraformType(ctx)
+	timeoutsType := tfType.(tftypes.Object).AttributeTypes["timeouts"]
+	tfTimeouts := tftypes.NewValue(timeoutsType, nil)
+	if readTimeout != "" {
+		tfTimeouts = tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+			"read": tftypes.NewValue(tftypes.String, readTimeout),
+		})
+	}
+	plan := tfsdk.Plan{Schema: tfSchema, Raw: tftypes.NewValue(tfType, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name":     tftypes.NewValue(tftypes.String, "created"),
+		"timeouts": tfTimeouts,
+	})}
+
+	// The private state is internal to the framework, so the zero value of its type is used.
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: tfSchema, Raw: tftypes.NewValue(tfType, nil)}}
+	reflect.ValueOf(&createResp.Private).Elem().Set(reflect.New(reflect.TypeOf(createResp.Private).Elem()))
+
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+	return createResp
+}
+
+func TestResourceWrapperCreateThenConfirm(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeConfirmResource{failedReads: 2}
+	var inner resource.Resource = fake
+	r := newResourceWrapper(&inner)
+
+	// The failed confirmation keeps the id of the created entity with the error, so it's tainted.
+	createResp := testUnconfirmedCreate(t, r, "")
+	if !createResp.Diagnostics.HasError() {
+		t.Fatal("expected Create() to return the error of the failed confirmation")
+	}
+	var id, name types.String
+	createResp.State.GetAttribute(ctx, path.Root("id"), &id)
+	createResp.State.GetAttribute(ctx, path.Root("name"), &name)
+	if id.ValueString() != "fake-id" || !name.IsNull() {
+		t.Fatalf("expected only the id to be recorded in state, got id=%s, name=%s", id, name)
+	}
+	if unconfirmed, _ := createResp.Private.GetKey(ctx, createUnconfirmedKey); len(unconfirmed) == 0 {
+		t.Fatal("expected the create to be marked as unconfirmed")
+	}
+
+	// The next refresh retries the read until the entity is confirmed.
+	readResp := resource.ReadResponse{State: createResp.State, Private: createResp.Private}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State, Private: createResp.Private}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read() returned errors: %v", readResp.Diagnostics)
+	}
+	readResp.State.GetAttribute(ctx, path.Root("name"), &name)
+	if name.ValueString() != "created" {
+		t.Errorf("expected the confirmed entity to be read, got name=%s", name)
+	}
+	if fake.reads != 3 {
+		t.Errorf("expected the read to be retried once, got %d reads", fake.reads)
+	}
+	if unconfirmed, _ := readResp.Private.GetKey(ctx, createUnconfirmedKey); len(unconfirmed) != 0 {
+		t.Error("expected the unconfirmed mark to be cleared")
+	}
+}
+
+func TestResourceWrapperConfirmReadTimeout(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeConfirmResource{failedReads: math.MaxInt}
+	var inner resource.Resource = fake
+	r := newResourceWrapper(&inner)
+	createResp := testUnconfirmedCreate(t, r, "2s")
+
+	// The confirmation read is retried within the read timeout of the state.
+	start := time.Now()
+	readResp := resource.ReadResponse{State: createResp.State, Private: createResp.Private}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State, Private: createResp.Private}, &readResp)
+	if !readResp.Diagnostics.HasError() {
+		t.Fatal("expected Read() to return the error of the failed confirmation")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected the read to stop after the read timeout, it took %s", elapsed)
+	}
+	if unconfirmed, _ := readResp.Private.GetKey(ctx, createUnconfirmedKey); len(unconfirmed) == 0 {
+		t.Error("expected the create to stay unconfirmed")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/solarwinds/terraform-provider-swo/internal/planmodifier/stringmodifier"
 )
@@ -20,6 +21,50 @@ func resourceIdAttribute() schema.StringAttribute {
 	}
 }
 
+func entityTagsAttribute() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Description: "Entity tags. Tag is a key-value pair, where there may be only single tag value for the same key.",
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"key": schema.StringAttribute{
+					Required: true,
+				},
+				"value": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func entityTagsAllAttribute() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Description: "The tags of the entity merged with the `default_tags` of the provider.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"key": schema.StringAttribute{
+					Computed: true,
+				},
+				"value": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func entityPausedAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Whether monitoring of the entity is paused. Pausing keeps the entity, its id and its history. " +
+			"Default is false.",
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
 func enrichSchema(s *schema.Schema) {
 	for i, attr := range s.Attributes {
 		s.Attributes[i] = enrichAttribute(attr)
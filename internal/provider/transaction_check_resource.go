@@ -0,0 +1,422 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/solarwinds/swo-sdk-go/swov1"
+	"github.com/solarwinds/swo-sdk-go/swov1/models/components"
+	"github.com/solarwinds/swo-sdk-go/swov1/models/operations"
+)
+
+var (
+	ErrNoTransactionDataReturned  = errors.New("no transaction data returned")
+	ErrTransactionEntityNoData    = errors.New("transaction entity exists but has no data")
+	ErrTransactionNameNotUpdated  = errors.New("transaction name not yet updated")
+	ErrTransactionStepsNotUpdated = errors.New("transaction steps not yet updated")
+	ErrTransactionTagsNotUpdated  = errors.New("transaction tags not yet updated")
+)
+
+// The commands of transaction steps.
+var transactionCommandNames = []components.DemTransactionCommandName{
+	components.DemTransactionCommandNameAssertChecked,
+	components.DemTransactionCommandNameAssertElementNotPresent,
+	components.DemTransactionCommandNameAssertElementPresent,
+	components.DemTransactionCommandNameAssertNotChecked,
+	components.DemTransactionCommandNameAssertNotSelectedValue,
+	components.DemTransactionCommandNameAssertNotText,
+	components.DemTransactionCommandNameAssertSelectedValue,
+	components.DemTransactionCommandNameAssertText,
+	components.DemTransactionCommandNameAssertValue,
+	components.DemTransactionCommandNameCheck,
+	components.DemTransactionCommandNameClick,
+	components.DemTransactionCommandNameOpen,
+	components.DemTransactionCommandNamePause,
+	components.DemTransactionCommandNameSwoAssertTextContains,
+	components.DemTransactionCommandNameSwoAssertTextNotContains,
+	components.DemTransactionCommandNameSwoAssertValueContains,
+	components.DemTransactionCommandNameSwoAssertValueNotContains,
+	components.DemTransactionCommandNameSelect,
+	components.DemTransactionCommandNameSelectFrame,
+	components.DemTransactionCommandNameSubmit,
+	components.DemTransactionCommandNameSwoAssertURL,
+	components.DemTransactionCommandNameSwoWaitForElementTextContains,
+	components.DemTransactionCommandNameType,
+	components.DemTransactionCommandNameUncheck,
+	components.DemTransactionCommandNameWaitForElementPresent,
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &transactionCheckResource{}
+	_ resource.ResourceWithConfigure   = &transactionCheckResource{}
+	_ resource.ResourceWithImportState = &transactionCheckResource{}
+	_ resource.ResourceWithModifyPlan  = &transactionCheckResource{}
+)
+
+func NewTransactionCheckResource() resource.Resource {
+	return &transactionCheckResource{}
+}
+
+// Defines the resource implementation.
+type transactionCheckResource struct {
+	client *swov1.Swo
+	tags   *entityTagsConfig
+}
+
+func (r *transactionCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "transaction_check"
+}
+
+func (r *transactionCheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, _ := req.ProviderData.(providerClients)
+	r.client = client.SwoV1Client
+	r.tags = client.EntityTags
+}
+
+func (r *transactionCheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.tags.modifyPlan(ctx, req, resp)
+}
+
+func (r *transactionCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var tfPlan transactionCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &tfPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createInput := transactionInputFromPlan(ctx, tfPlan, r.tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the transaction...
+	res, err := r.client.Dem.CreateTransaction(ctx, createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error creating transaction check '%s' - error: %s", tfPlan.Name.ValueString(), err))
+		return
+	}
+
+	if res.CommonEntityID == nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error creating transaction check '%s' - no entity ID returned", tfPlan.Name.ValueString()))
+		return
+	}
+
+	tfPlan.Id = types.StringValue(res.CommonEntityID.GetID())
+	resp.Diagnostics.Append(resp.State.Set(ctx, tfPlan)...)
+}
+
+func (r *transactionCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var tfState transactionCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &tfState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transaction, err := transactionReadRetry(ctx, tfState.Id.ValueString(), r.readTransaction)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error reading transaction check %s. error: %s", tfState.Id, err))
+		return
+	}
+
+	setTransactionValuesFromRead(ctx, transaction, r.tags, &tfState, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, tfState)...)
+}
+
+func (r *transactionCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var tfPlan, tfState *transactionCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &tfPlan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &tfState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateInput := transactionInputFromPlan(ctx, *tfPlan, r.tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags that are managed outside terraform are kept.
+	if r.tags.ignoresTags() {
+		current, err := r.readTransaction(ctx, tfState.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("error reading transaction check %s before update. err: %s", tfState.Id, err))
+			return
+		}
+		updateInput.Tags = append(updateInput.Tags, r.tags.ignoredTags(current.Tags)...)
+	}
+
+	// Update the transaction...
+	_, err := r.client.Dem.UpdateTransaction(ctx, operations.UpdateTransactionRequest{
+		EntityID:       tfState.Id.ValueString(),
+		DemTransaction: updateInput,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error updating transaction check %s. err: %s", tfState.Id, err))
+		return
+	}
+
+	// Updates are eventually consistent. Retry until the transaction we read and the transaction we are updating match.
+	_, err = transactionReadRetry(ctx, tfState.Id.ValueString(), func(ctx context.Context, id string) (*components.DemGetTransactionResponse, error) {
+		transaction, err := r.readTransaction(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if transaction.Name != updateInput.Name {
+			return nil, ErrTransactionNameNotUpdated
+		}
+		if len(transaction.TestDefinition.Commands) != len(updateInput.TestDefinition.Commands) {
+			return nil, ErrTransactionStepsNotUpdated
+		}
+		if len(transaction.Tags) != len(updateInput.Tags) {
+			return nil, ErrTransactionTagsNotUpdated
+		}
+		return transaction, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error updating transaction check %s. err: %s", tfState.Id, err))
+		return
+	}
+
+	// Save to Terraform state.
+	tfPlan.Id = tfState.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tfPlan)...)
+}
+
+func (r *transactionCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var tfState transactionCheckResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &tfState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the transaction...
+	if _, err := r.client.Dem.DeleteTransaction(ctx, operations.DeleteTransactionRequest{EntityID: tfState.Id.ValueString()}); err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error deleting transaction check %s - %s", tfState.Id, err))
+	}
+}
+
+func (r *transactionCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readTransaction returns the transaction with the given id.
+func (r *transactionCheckResource) readTransaction(ctx context.Context, id string) (*components.DemGetTransactionResponse, error) {
+	transactionResp, err := r.client.Dem.GetTransaction(ctx, operations.GetTransactionRequest{EntityID: id})
+	if err != nil {
+		return nil, err
+	}
+	if transactionResp.DemGetTransactionResponse == nil {
+		return nil, ErrNoTransactionDataReturned
+	}
+	return transactionResp.DemGetTransactionResponse, nil
+}
+
+// transactionReadRetry retries the read operation until the transaction is returned. Transaction creates and
+// updates are eventually consistent.
+func transactionReadRetry(ctx context.Context, id string, operation ReadOperation[*components.DemGetTransactionResponse]) (*components.DemGetTransactionResponse, error) {
+	return BackoffRetry(ctx, func() (*components.DemGetTransactionResponse, error) {
+		transaction, err := operation(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if transaction.ID == "" {
+			return nil, ErrTransactionEntityNoData
+		}
+		return transaction, nil
+	})
+}
+
+// transactionInputFromPlan returns the transaction of the plan for the DEM API.
+func transactionInputFromPlan(ctx context.Context, tfPlan transactionCheckResourceModel, tagsConfig *entityTagsConfig, diags *diag.Diagnostics) components.DemTransaction {
+	var testDefinitions transactionCheckTestDefinitions
+	diags.Append(tfPlan.TestDefinitions.As(ctx, &testDefinitions, basetypes.ObjectAsOptions{})...)
+	var locationOptions []probeLocation
+	diags.Append(testDefinitions.LocationOptions.ElementsAs(ctx, &locationOptions, false)...)
+	var windowSize transactionCheckWindowSize
+	diags.Append(testDefinitions.WindowSize.As(ctx, &windowSize, basetypes.ObjectAsOptions{})...)
+	var steps []transactionCheckStep
+	diags.Append(tfPlan.Steps.ElementsAs(ctx, &steps, false)...)
+	tags := tagsConfig.merged(commonTagsFromSet(ctx, tfPlan.Tags, diags))
+	if diags.HasError() {
+		return components.DemTransaction{}
+	}
+
+	testFromType, err := stringToTestFromType(testDefinitions.TestFromLocation.ValueString())
+	if err != nil {
+		diags.AddError("Invalid Transaction Check Configuration", err.Error())
+		return components.DemTransaction{}
+	}
+
+	testDefinition := components.DemTransactionTestDefinition{
+		TestFrom: components.DemTestFrom{
+			Type: testFromType,
+			Values: convertArray(locationOptions,
+				func(v probeLocation) string { return v.Value.ValueString() }),
+		},
+		TestIntervalInSeconds: float64(testDefinitions.TestIntervalInSeconds.ValueInt64()),
+		WindowSize: components.DemWindowSize{
+			Width:  int(windowSize.Width.ValueInt64()),
+			Height: int(windowSize.Height.ValueInt64()),
+		},
+		UserAgent: testDefinitions.UserAgent.ValueStringPointer(),
+		Commands: convertArray(steps, func(s transactionCheckStep) components.DemTransactionCommand {
+			return components.DemTransactionCommand{
+				Command:     components.DemTransactionCommandName(s.Command.ValueString()),
+				Target:      s.Target.ValueStringPointer(),
+				DisplayText: s.DisplayText.ValueStringPointer(),
+				Value:       s.Value.ValueStringPointer(),
+			}
+		}),
+	}
+
+	if !testDefinitions.PlatformOptions.IsNull() {
+		var planPlatformOptions platformOptions
+		diags.Append(testDefinitions.PlatformOptions.As(ctx, &planPlatformOptions, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return components.DemTransaction{}
+		}
+		probePlatforms, err := mapPlatformsFromTerraform(planPlatformOptions.Platforms)
+		if err != nil {
+			diags.AddError("Invalid Transaction Check Configuration", err.Error())
+			return components.DemTransaction{}
+		}
+		testDefinition.PlatformOptions = &components.PlatformOptions{
+			ProbePlatforms: probePlatforms,
+			TestFromAll:    planPlatformOptions.TestFromAll.ValueBoolPointer(),
+		}
+	}
+
+	if !testDefinitions.OutageConfig.IsNull() {
+		var planOutageConfig outageConfig
+		diags.Append(testDefinitions.OutageConfig.As(ctx, &planOutageConfig, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return components.DemTransaction{}
+		}
+		testDefinition.OutageConfiguration = &components.OutageConfiguration{
+			FailingTestLocations: components.DemTransactionTestDefinitionFailingTestLocations(planOutageConfig.FailingTestLocations.ValueString()),
+			ConsecutiveForDown:   int(planOutageConfig.ConsecutiveForDown.ValueInt64()),
+		}
+	}
+
+	return components.DemTransaction{
+		Name:            tfPlan.Name.ValueString(),
+		Description:     tfPlan.Description.ValueStringPointer(),
+		RelatedEntityID: tfPlan.RelatedEntityId.ValueStringPointer(),
+		TestDefinition:  testDefinition,
+		Tags:            tags,
+	}
+}
+
+// setTransactionValuesFromRead sets the values of the terraform state with the values returned from the Read request.
+func setTransactionValuesFromRead(ctx context.Context, transaction *components.DemGetTransactionResponse, tagsConfig *entityTagsConfig, tfState *transactionCheckResourceModel, diags *diag.Diagnostics) {
+	testDefinition := transaction.TestDefinition
+	tfState.Name = types.StringValue(transaction.Name)
+	tfState.Description = types.StringPointerValue(transaction.Description)
+	tfState.RelatedEntityId = types.StringPointerValue(transaction.RelatedEntityID)
+	tfState.Tags, tfState.TagsAll = tagsConfig.stateTags(ctx, transaction.Tags, tfState.Tags, diags)
+	if diags.HasError() {
+		return
+	}
+
+	// TestDefinitions
+	testDefsElements := transactionCheckTestDefinitions{
+		TestFromLocation:      types.StringValue(string(testDefinition.TestFrom.Type)),
+		TestIntervalInSeconds: types.Int64Value(int64(testDefinition.TestIntervalInSeconds)),
+		PlatformOptions:       types.ObjectNull(PlatformOptionsAttributeTypes()),
+		OutageConfig:          types.ObjectNull(OutageConfigAttributeTypes()),
+		UserAgent:             types.StringPointerValue(testDefinition.UserAgent),
+	}
+
+	var locationOptsElements []attr.Value
+	for _, value := range testDefinition.TestFrom.Values {
+		objectValue, d := types.ObjectValueFrom(ctx, ProbeLocationAttributeTypes(), probeLocation{
+			Type:  types.StringValue(string(testDefinition.TestFrom.Type)),
+			Value: types.StringValue(value),
+		})
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+		locationOptsElements = append(locationOptsElements, objectValue)
+	}
+	var d diag.Diagnostics
+	testDefsElements.LocationOptions, d = types.SetValueFrom(ctx,
+		types.ObjectType{AttrTypes: ProbeLocationAttributeTypes()}, locationOptsElements)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	if testDefinition.PlatformOptions != nil {
+		platforms, d := types.SetValueFrom(ctx, types.StringType, testDefinition.PlatformOptions.ProbePlatforms)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+		platformElements := platformOptions{
+			TestFromAll: types.BoolValue(testDefinition.PlatformOptions.TestFromAll != nil && *testDefinition.PlatformOptions.TestFromAll),
+			Platforms:   platforms,
+		}
+		testDefsElements.PlatformOptions, d = types.ObjectValueFrom(ctx, PlatformOptionsAttributeTypes(), platformElements)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if testDefinition.OutageConfiguration != nil {
+		testDefsElements.OutageConfig, d = types.ObjectValueFrom(ctx, OutageConfigAttributeTypes(), outageConfig{
+			FailingTestLocations: types.StringValue(string(testDefinition.OutageConfiguration.FailingTestLocations)),
+			ConsecutiveForDown:   types.Int64Value(int64(testDefinition.OutageConfiguration.ConsecutiveForDown)),
+		})
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	testDefsElements.WindowSize, d = types.ObjectValueFrom(ctx, TransactionCheckWindowSizeAttributeTypes(), transactionCheckWindowSize{
+		Width:  types.Int64Value(int64(testDefinition.WindowSize.Width)),
+		Height: types.Int64Value(int64(testDefinition.WindowSize.Height)),
+	})
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	tfState.TestDefinitions, d = types.ObjectValueFrom(ctx, TransactionCheckTestDefAttributeTypes(), testDefsElements)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	// Steps
+	steps := convertArray(testDefinition.Commands, func(c components.DemTransactionCommand) transactionCheckStep {
+		return transactionCheckStep{
+			Command:     types.StringValue(string(c.Command)),
+			Target:      types.StringPointerValue(c.Target),
+			DisplayText: types.StringPointerValue(c.DisplayText),
+			Value:       types.StringPointerValue(c.Value),
+		}
+	})
+	tfState.Steps, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: TransactionCheckStepAttributeTypes()}, steps)
+	diags.Append(d...)
+}
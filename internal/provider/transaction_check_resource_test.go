@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTransactionCheckResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTransactionCheckResourceConfig("test-acc test one [CREATE_TEST]", "Checkout"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("swo_transaction_check.test", "id"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "name", "test-acc test one [CREATE_TEST]"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "description", "Checkout flow"),
+					//tag object order can be changed. Check for total number and nothing else.
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "tags.#", "1"),
+
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "test_definitions.test_from_location", "REGION"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "test_definitions.test_interval_in_seconds", "300"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "test_definitions.location_options.#", "1"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "test_definitions.location_options.0.type", "REGION"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "test_definitions.location_options.0.value", "NA"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "test_definitions.platform_options.test_from_all", "false"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "test_definitions.platform_options.platforms.#", "1"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "test_definitions.platform_options.platforms.0", "AWS"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "test_definitions.window_size.width", "1920"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "test_definitions.window_size.height", "1080"),
+
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "steps.#", "4"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "steps.0.command", "OPEN"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "steps.0.target", "https://example.com/cart"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "steps.1.command", "TYPE"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "steps.1.value", "test@example.com"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "steps.2.command", "CLICK"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "steps.2.display_text", "Checkout"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "steps.3.command", "SWO_ASSERT_URL"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "swo_transaction_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTransactionCheckResourceConfig("test-acc test two [UPDATE_TEST]", "Pay now"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "name", "test-acc test two [UPDATE_TEST]"),
+					resource.TestCheckResourceAttr("swo_transaction_check.test", "steps.2.display_text", "Pay now"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// Only supported regions (location_options.value) in Dev and Stage are NA
+// Production supports the following: NA, AS, SA, OC
+func testAccTransactionCheckResourceConfig(name string, buttonText string) string {
+	return providerConfig() + fmt.Sprintf(`
+	resource "swo_transaction_check" "test" {
+		name        = %q
+		description = "Checkout flow"
+
+		tags = [
+			{
+				key   = "team"
+				value = "payments"
+			}
+		]
+
+		test_definitions = {
+			test_from_location = "REGION"
+
+			location_options = [
+				{
+					type  = "REGION"
+					value = "NA"
+				}
+			]
+
+			test_interval_in_seconds = 300
+
+			platform_options = {
+				test_from_all = false
+				platforms     = ["AWS"]
+			}
+
+			window_size = {
+				width  = 1920
+				height = 1080
+			}
+		}
+
+		steps = [
+			{
+				command = "OPEN"
+				target  = "https://example.com/cart"
+			},
+			{
+				command      = "TYPE"
+				target       = "id=email"
+				display_text = "Email"
+				value        = "test@example.com"
+			},
+			{
+				command      = "CLICK"
+				target       = "css=button.checkout"
+				display_text = %q
+			},
+			{
+				command = "SWO_ASSERT_URL"
+				target  = "https://example.com/checkout"
+			}
+		]
+	}`, name, buttonText)
+}
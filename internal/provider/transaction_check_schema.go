@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/solarwinds/swo-sdk-go/swov1/models/components"
+	"github.com/solarwinds/terraform-provider-swo/internal/validators"
+)
+
+// transactionCheckResourceModel is the main resource structure
+type transactionCheckResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	RelatedEntityId types.String `tfsdk:"related_entity_id"`
+	Tags            types.Set    `tfsdk:"tags"`
+	TagsAll         types.Set    `tfsdk:"tags_all"`
+	TestDefinitions types.Object `tfsdk:"test_definitions"` //transactionCheckTestDefinitions
+	Steps           types.List   `tfsdk:"steps"`            //transactionCheckStep
+}
+
+type transactionCheckTestDefinitions struct {
+	TestFromLocation      types.String `tfsdk:"test_from_location"`
+	LocationOptions       types.Set    `tfsdk:"location_options"` //probeLocation
+	TestIntervalInSeconds types.Int64  `tfsdk:"test_interval_in_seconds"`
+	PlatformOptions       types.Object `tfsdk:"platform_options"`     //platformOptions
+	OutageConfig          types.Object `tfsdk:"outage_configuration"` //outageConfig
+	WindowSize            types.Object `tfsdk:"window_size"`          //transactionCheckWindowSize
+	UserAgent             types.String `tfsdk:"user_agent"`
+}
+
+func TransactionCheckTestDefAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"test_from_location":       types.StringType,
+		"location_options":         types.SetType{ElemType: types.ObjectType{AttrTypes: ProbeLocationAttributeTypes()}},
+		"test_interval_in_seconds": types.Int64Type,
+		"platform_options":         types.ObjectType{AttrTypes: PlatformOptionsAttributeTypes()},
+		"outage_configuration":     types.ObjectType{AttrTypes: OutageConfigAttributeTypes()},
+		"window_size":              types.ObjectType{AttrTypes: TransactionCheckWindowSizeAttributeTypes()},
+		"user_agent":               types.StringType,
+	}
+}
+
+type transactionCheckWindowSize struct {
+	Width  types.Int64 `tfsdk:"width"`
+	Height types.Int64 `tfsdk:"height"`
+}
+
+func TransactionCheckWindowSizeAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"width":  types.Int64Type,
+		"height": types.Int64Type,
+	}
+}
+
+type transactionCheckStep struct {
+	Command     types.String `tfsdk:"command"`
+	Target      types.String `tfsdk:"target"`
+	DisplayText types.String `tfsdk:"display_text"`
+	Value       types.String `tfsdk:"value"`
+}
+
+func TransactionCheckStepAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"command":      types.StringType,
+		"target":       types.StringType,
+		"display_text": types.StringType,
+		"value":        types.StringType,
+	}
+}
+
+func (r *transactionCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A terraform resource for managing synthetic transaction checks, which run a sequence of browser " +
+			"steps, e.g. a login or checkout flow.",
+		Attributes: map[string]schema.Attribute{
+			"id": resourceIdAttribute(),
+			"name": schema.StringAttribute{
+				Description: "The name of this transaction check, which must be unique within the organization.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of this transaction check.",
+				Optional:    true,
+			},
+			"related_entity_id": schema.StringAttribute{
+				Description: "The Id of an entity the transaction check is connected to, e.g. a `swo_website`.",
+				Optional:    true,
+			},
+			"tags":     entityTagsAttribute(),
+			"tags_all": entityTagsAllAttribute(),
+			"test_definitions": schema.SingleNestedAttribute{
+				Description: "The test definitions for this transaction check.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"test_from_location": schema.StringAttribute{
+						Description: "The location type to test from. Valid values are [`REGION`|`COUNTRY`|`CITY`].",
+						Required:    true,
+						Validators: []validator.String{
+							validators.OneOf(
+								components.TypeRegion,
+								components.TypeCountry,
+								components.TypeCity),
+						},
+					},
+					"location_options": schema.SetNestedAttribute{
+						Description: "The locations to test from.",
+						Required:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Description: "The location option type. Valid values are [`REGION`|`COUNTRY`|`CITY`].",
+									Required:    true,
+									Validators: []validator.String{
+										validators.OneOf(
+											components.TypeRegion,
+											components.TypeCountry,
+											components.TypeCity),
+									},
+								},
+								"value": schema.StringAttribute{
+									Description: "The location option value.",
+									Required:    true,
+								},
+							},
+						},
+					},
+					"test_interval_in_seconds": schema.Int64Attribute{
+						Description: "The interval to test in seconds. " +
+							"Valid values are 300, 600, 900, 1800, 3600, 7200, 14400, 43200, 86400.",
+						Required: true,
+						Validators: []validator.Int64{
+							int64validator.OneOf(300, 600, 900, 1800, 3600, 7200, 14400, 43200, 86400),
+						},
+					},
+					"platform_options": schema.SingleNestedAttribute{
+						Description: "The platform options for this transaction check.",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"test_from_all": schema.BoolAttribute{
+								Description: "Whether or not to test from all platforms.",
+								Required:    true,
+							},
+							"platforms": schema.SetAttribute{
+								Description: "The platforms to test from. Valid values are [`AWS`, `AZURE`, `GOOGLE_CLOUD`].",
+								Required:    true,
+								ElementType: types.StringType,
+								Validators: []validator.Set{
+									setvalidator.ValueStringsAre(validators.OneOf(
+										components.DemProbePlatformAws,
+										components.DemProbePlatformAzure,
+										components.DemProbePlatformGoogleCloud)),
+								},
+							},
+						},
+					},
+					"outage_configuration": schema.SingleNestedAttribute{
+						Description: "Default conditions when the entity is considered down. " +
+							"If omitted or set to null, organization configuration will be used for this entity.",
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"failing_test_locations": schema.StringAttribute{
+								Description: "How many locations must report a failure for an entity to be considered down. " +
+									"Valid values are [`all`, `any`].",
+								Required: true,
+								Validators: []validator.String{
+									validators.OneOf(
+										components.DemTransactionTestDefinitionFailingTestLocationsAll,
+										components.DemTransactionTestDefinitionFailingTestLocationsAny,
+									),
+								},
+							},
+							"consecutive_for_down": schema.Int64Attribute{
+								Description: "Number of consecutive failing tests for an entity to be considered down. Minimum 1.",
+								Required:    true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+						},
+					},
+					"window_size": schema.SingleNestedAttribute{
+						Description: "The browser window size the transaction runs in.",
+						Required:    true,
+						Attributes: map[string]schema.Attribute{
+							"width": schema.Int64Attribute{
+								Description: "The browser window width in pixels.",
+								Required:    true,
+							},
+							"height": schema.Int64Attribute{
+								Description: "The browser window height in pixels.",
+								Required:    true,
+							},
+						},
+					},
+					"user_agent": schema.StringAttribute{
+						Description: "The user agent of the browser. If omitted, the default user agent is used.",
+						Optional:    true,
+					},
+				},
+			},
+			"steps": schema.ListNestedAttribute{
+				Description: "The ordered steps of the transaction. A step is a browser command, e.g. `OPEN` a URL, " +
+					"`TYPE` a value into an element, `CLICK` an element, or an assertion such as `SWO_ASSERT_URL` or " +
+					"`SWO_ASSERT_TEXT_CONTAINS`.",
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.StringAttribute{
+							Description: "The command of the step, e.g. [`OPEN`|`CLICK`|`TYPE`|`SUBMIT`|`PAUSE`|" +
+								"`SWO_ASSERT_URL`|`SWO_ASSERT_TEXT_CONTAINS`|`WAIT_FOR_ELEMENT_PRESENT`].",
+							Required: true,
+							Validators: []validator.String{
+								validators.OneOf(transactionCommandNames...),
+							},
+						},
+						"target": schema.StringAttribute{
+							Description: "The target of the command, e.g. the URL to open, or a locator of the element " +
+								"to interact with such as `id=email` or `css=button.checkout`.",
+							Optional: true,
+						},
+						"display_text": schema.StringAttribute{
+							Description: "A readable representation of the target element. " +
+								"Omit it for commands that don't target elements, e.g. `OPEN` and `PAUSE`.",
+							Optional: true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the command, e.g. the text to type or the text to assert.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
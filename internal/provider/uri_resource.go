@@ -1,27 +1,43 @@
 package provider
 
 import (
+	"cmp"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"reflect"
-
-	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"slices"
+	"time"
 
 	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	swoClient "github.com/solarwinds/swo-client-go/pkg/client"
-	swoClientTypes "github.com/solarwinds/swo-client-go/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/solarwinds/swo-sdk-go/swov1"
+	"github.com/solarwinds/swo-sdk-go/swov1/models/components"
+	"github.com/solarwinds/swo-sdk-go/swov1/models/operations"
+	"github.com/solarwinds/terraform-provider-swo/internal/typex"
+)
+
+var (
+	ErrNoUriDataReturned     = errors.New("no uri data returned")
+	ErrUriEntityNoData       = errors.New("uri entity exists but has no data")
+	ErrUriNameNotUpdated     = errors.New("uri name not yet updated")
+	ErrUriHostNotUpdated     = errors.New("uri host not yet updated")
+	ErrUriTagsNotUpdated     = errors.New("uri tags not yet updated")
+	ErrUriPausedNotUpdated   = errors.New("uri paused status not yet updated")
+	ErrUriSettingsNotUpdated = errors.New("uri availability settings not yet updated")
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &uriResource{}
-	_ resource.ResourceWithConfigure   = &uriResource{}
-	_ resource.ResourceWithImportState = &uriResource{}
+	_ resource.Resource                   = &uriResource{}
+	_ resource.ResourceWithConfigure      = &uriResource{}
+	_ resource.ResourceWithImportState    = &uriResource{}
+	_ resource.ResourceWithModifyPlan     = &uriResource{}
+	_ resource.ResourceWithValidateConfig = &uriResource{}
 )
 
 func NewUriResource() resource.Resource {
@@ -30,7 +46,8 @@ func NewUriResource() resource.Resource {
 
 // Defines the resource implementation.
 type uriResource struct {
-	client *swoClient.Client
+	client *swov1.Swo
+	tags   *entityTagsConfig
 }
 
 func (r *uriResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,389 +56,589 @@ func (r *uriResource) Metadata(ctx context.Context, req resource.MetadataRequest
 
 func (r *uriResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, _ := req.ProviderData.(providerClients)
-	r.client = client.SwoClient
+	r.client = client.SwoV1Client
+	r.tags = client.EntityTags
 }
 
-func (r *uriResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var tfPlan uriResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &tfPlan)...)
-	if resp.Diagnostics.HasError() {
+// ValidateConfig checks that exactly one check mode is enabled, and that the dns and udp modes have their options.
+func (r *uriResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var tfConfig uriResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfConfig)...)
+	if resp.Diagnostics.HasError() || tfConfig.Options.IsNull() || tfConfig.Options.IsUnknown() {
 		return
 	}
 
-	var planOptions uriResourceOptions
-	d := tfPlan.Options.As(ctx, &planOptions, basetypes.ObjectAsOptions{})
-	resp.Diagnostics.Append(d...)
+	var options uriResourceOptions
+	resp.Diagnostics.Append(tfConfig.Options.As(ctx, &options, basetypes.ObjectAsOptions{})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var tcpOptions uriResourceTcpOptions
-	d = tfPlan.TcpOptions.As(ctx, &tcpOptions, basetypes.ObjectAsOptions{})
-	resp.Diagnostics.Append(d...)
-	if resp.Diagnostics.HasError() {
-		return
+
+	enabled := 0
+	for _, mode := range []types.Bool{options.IsPingEnabled, options.IsTcpEnabled, options.IsDnsEnabled, options.IsUdpEnabled} {
+		if mode.IsUnknown() {
+			return
+		}
+		if mode.ValueBool() {
+			enabled++
+		}
 	}
-	var testDefinitions uriResourceTestDefinitions
-	d = tfPlan.TestDefinitions.As(ctx, &testDefinitions, basetypes.ObjectAsOptions{})
-	resp.Diagnostics.Append(d...)
+	if enabled != 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("options"), "Invalid Uri Configuration",
+			fmt.Sprintf("Exactly one of ping, tcp, dns and udp monitoring must be enabled, got %d.", enabled))
+	}
+
+	if options.IsDnsEnabled.ValueBool() && tfConfig.DnsOptions.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("dns_options"), "Invalid Uri Configuration",
+			"dns_options are required when is_dns_enabled is true.")
+	}
+	if options.IsUdpEnabled.ValueBool() && tfConfig.UdpOptions.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("udp_options"), "Invalid Uri Configuration",
+			"udp_options are required when is_udp_enabled is true.")
+	}
+}
+
+func (r *uriResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.tags.modifyPlan(ctx, req, resp)
+}
+
+func (r *uriResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var tfPlan uriResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &tfPlan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var planPlatformOptions uriResourcePlatformOptions
-	d = testDefinitions.PlatformOptions.As(ctx, &planPlatformOptions, basetypes.ObjectAsOptions{})
+
+	createTimeout, d := tfPlan.Timeouts.Create(ctx, expBackoffMaxElapsed)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var locationOptions []uriResourceProbeLocation
-	d = testDefinitions.LocationOptions.ElementsAs(ctx, &locationOptions, false)
-	resp.Diagnostics.Append(d...)
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	createInput := uriInputFromPlan(ctx, tfPlan, r.tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	// Create our input request.
-	createInput := swoClient.CreateUriInput{
-		Name:       tfPlan.Name.ValueString(),
-		IpOrDomain: tfPlan.Host.ValueString(),
-		PingOptions: &swoClient.UriPingOptionsInput{
-			Enabled: planOptions.IsPingEnabled.ValueBool(),
-		},
-		TcpOptions: &swoClient.UriTcpOptionsInput{
-			Enabled:        planOptions.IsTcpEnabled.ValueBool(),
-			Port:           int(tcpOptions.Port.ValueInt64()),
-			StringToExpect: tcpOptions.StringToExpect.ValueStringPointer(),
-			StringToSend:   tcpOptions.StringToSend.ValueStringPointer(),
-		},
-		TestDefinitions: swoClient.UriTestDefinitionsInput{
-			PlatformOptions: &swoClient.ProbePlatformOptionsInput{
-				TestFromAll: planPlatformOptions.TestFromAll.ValueBoolPointer(),
-				ProbePlatforms: convertArray(planPlatformOptions.Platforms.Elements(),
-					func(s attr.Value) swoClient.ProbePlatform { return swoClient.ProbePlatform(attrValueToString(s)) }),
-			},
-			TestFrom: swoClient.ProbeLocationInput{
-				Type: swoClient.ProbeLocationType(testDefinitions.TestFromLocation.ValueString()),
-				Values: convertArray(locationOptions,
-					func(v uriResourceProbeLocation) string { return v.Value.ValueString() }),
-			},
-			TestIntervalInSeconds: swoClientTypes.TestIntervalInSeconds(int(testDefinitions.TestIntervalInSeconds.ValueInt64())),
-		},
-	}
 
 	// Create the Uri...
-	newUri, err := r.client.UriService().Create(ctx, createInput)
+	res, err := r.client.Dem.CreateURI(ctx, createInput)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error creating uri '%s' - error: %s", tfPlan.Name, err))
+			fmt.Sprintf("error creating uri '%s' - error: %s", tfPlan.Name.ValueString(), err))
 		return
 	}
 
-	tfPlan.Id = types.StringValue(newUri.Id)
-	resp.Diagnostics.Append(resp.State.Set(ctx, tfPlan)...)
+	if res.CommonEntityID == nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error creating uri '%s' - no entity ID returned", tfPlan.Name.ValueString()))
+		return
+	}
+
+	// Record the created uri before it is confirmed.
+	tfPlan.Id = types.StringValue(res.CommonEntityID.GetID())
+	createThenConfirm(ctx, req, resp, tfPlan.Id.ValueString(), func() {
+		// A uri is created with active monitoring. An error leaves the created uri tainted.
+		if tfPlan.Paused.ValueBool() {
+			if err := r.setPaused(ctx, tfPlan.Id.ValueString(), true); err != nil {
+				resp.Diagnostics.AddError("Client Error",
+					fmt.Sprintf("error pausing uri '%s' - error: %s", tfPlan.Name.ValueString(), err))
+			}
+		}
+
+		// Creates are eventually consistent. Confirm the uri is returned.
+		if _, err := uriReadRetry(ctx, tfPlan.Id.ValueString(), createTimeout, r.readUri); err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("error reading uri '%s' after create - error: %s", tfPlan.Name.ValueString(), err))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, tfPlan)...)
+	})
 }
 
 func (r *uriResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var tfState uriResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &tfState)...)
-
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	uri, err := ReadRetry(ctx, tfState.Id.ValueString(), r.client.UriService().Read)
+	readTimeout, d := tfState.Timeouts.Read(ctx, expBackoffMaxElapsed)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
+	uri, err := uriReadRetry(ctx, tfState.Id.ValueString(), readTimeout, r.readUri)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
 			fmt.Sprintf("error reading uri %s. error: %s", tfState.Id, err))
 		return
 	}
 
-	// Update the Terraform state.
-	tfState.Host = types.StringValue(uri.Host)
-	tfState.Name = types.StringPointerValue(uri.Name)
-
-	// Options
-	optionsElement := uriResourceOptions{
-		IsPingEnabled: types.BoolValue(uri.Options.IsPingEnabled),
-		IsTcpEnabled:  types.BoolValue(uri.Options.IsTcpEnabled),
-	}
-	tfOptions, diags := types.ObjectValueFrom(ctx, UriResourceOptionsAttributeTypes(), optionsElement)
-	resp.Diagnostics.Append(diags...)
+	setUriValuesFromRead(ctx, uri, r.tags, &tfState, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	tfState.Options = tfOptions
-
-	// TcpOptions
-	if uri.TcpOptions != nil {
-		tcpOptions := uri.TcpOptions
-		tcpElement := uriResourceTcpOptions{
-			Port:           types.Int64Value(int64(tcpOptions.Port)),
-			StringToExpect: types.StringNull(),
-			StringToSend:   types.StringNull(),
-		}
-		if tcpOptions.StringToExpect != nil {
-			tcpElement.StringToExpect = types.StringValue(*tcpOptions.StringToExpect)
-		}
-		if tcpOptions.StringToSend != nil {
-			tcpElement.StringToSend = types.StringValue(*tcpOptions.StringToSend)
-		}
-
-		tfTcpOptions, d := types.ObjectValueFrom(ctx, UriTcpOptionsAttributeTypes(), tcpElement)
-		resp.Diagnostics.Append(d...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		tfState.TcpOptions = tfTcpOptions
-	} else {
-		tfState.TcpOptions = types.ObjectNull(UriTcpOptionsAttributeTypes())
-	}
-
-	// TestDefinitions
-	testDefs := uri.TestDefinitions
-	platformElementTypes := UriPlatformOptionsAttributeTypes()
-	locationOptsElementTypes := UriProbeLocationAttributeTypes()
-	testDefsElementTypes := UriTestDefAttributeTypes()
+	resp.Diagnostics.Append(resp.State.Set(ctx, tfState)...)
+}
 
-	testDefsElements := uriResourceTestDefinitions{
-		TestFromLocation:      types.StringNull(),
-		LocationOptions:       types.SetUnknown(types.ObjectType{AttrTypes: locationOptsElementTypes}),
-		TestIntervalInSeconds: types.Int64Null(),
-		PlatformOptions:       types.ObjectNull(UriPlatformOptionsAttributeTypes()),
-	}
-	if testDefs.TestFromLocation != nil {
-		testDefsElements.TestFromLocation = types.StringValue(string(*testDefs.TestFromLocation))
+func (r *uriResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var tfPlan, tfState *uriResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &tfPlan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &tfState)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	var locationOptsElements []attr.Value
-	for _, x := range testDefs.LocationOptions {
-		objectValue, d := types.ObjectValueFrom(
-			ctx,
-			locationOptsElementTypes,
-			uriResourceProbeLocation{
-				Type:  types.StringValue(string(x.Type)),
-				Value: types.StringValue(x.Value),
-			},
-		)
-
-		resp.Diagnostics.Append(d...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		locationOptsElements = append(locationOptsElements, objectValue)
-	}
-	locationOpts, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: locationOptsElementTypes}, locationOptsElements)
+	updateTimeout, d := tfPlan.Timeouts.Update(ctx, expBackoffMaxElapsed)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	testDefsElements.LocationOptions = locationOpts
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-	if testDefs.TestIntervalInSeconds != nil {
-		testDefsElements.TestIntervalInSeconds = types.Int64Value(int64(*testDefs.TestIntervalInSeconds))
+	updateInput := uriInputFromPlan(ctx, *tfPlan, r.tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if testDefs.PlatformOptions != nil {
-		listValue, d := types.SetValueFrom(ctx, types.StringType, testDefs.PlatformOptions.Platforms)
-		resp.Diagnostics.Append(d...)
-		if resp.Diagnostics.HasError() {
+	// Tags that are managed outside terraform are kept.
+	if r.tags.ignoresTags() {
+		current, err := r.readUri(ctx, tfState.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("error reading uri %s before update. err: %s", tfState.Id, err))
 			return
 		}
-		platformElements := uriResourcePlatformOptions{
-			TestFromAll: types.BoolValue(testDefs.PlatformOptions.TestFromAll),
-			Platforms:   listValue,
-		}
+		updateInput.Tags = append(updateInput.Tags, r.tags.ignoredTags(current.Tags)...)
+	}
 
-		tfPlatformOptions, d := types.ObjectValueFrom(ctx, platformElementTypes, platformElements)
-		resp.Diagnostics.Append(d...)
-		if resp.Diagnostics.HasError() {
+	// Update the Uri...
+	_, err := r.client.Dem.UpdateURI(ctx, operations.UpdateURIRequest{
+		EntityID: tfState.Id.ValueString(),
+		DemURI:   updateInput,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error updating uri %s. err: %s", tfState.Id, err))
+		return
+	}
+
+	if !tfPlan.Paused.Equal(tfState.Paused) {
+		if err := r.setPaused(ctx, tfState.Id.ValueString(), tfPlan.Paused.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("error updating paused status of uri %s. err: %s", tfState.Id, err))
 			return
 		}
-		testDefsElements.PlatformOptions = tfPlatformOptions
 	}
 
-	objectValue, d := types.ObjectValueFrom(ctx, testDefsElementTypes, testDefsElements)
-	resp.Diagnostics.Append(d...)
-	if resp.Diagnostics.HasError() {
+	// Updates are eventually consistent. Retry until the URI we read and the URI we are updating match.
+	_, err = uriReadRetry(ctx, tfState.Id.ValueString(), updateTimeout, func(ctx context.Context, id string) (*components.DemGetURIResponse, error) {
+		uri, err := r.readUri(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if uri.Name != updateInput.Name {
+			return nil, ErrUriNameNotUpdated
+		}
+		if uri.IPOrDomain != updateInput.IPOrDomain {
+			return nil, ErrUriHostNotUpdated
+		}
+		if !uriTagsEqual(uri.Tags, updateInput.Tags) {
+			return nil, ErrUriTagsNotUpdated
+		}
+		if !uriSettingsUpdated(uri.AvailabilityCheckSettings, updateInput.AvailabilityCheckSettings) {
+			return nil, ErrUriSettingsNotUpdated
+		}
+		if (uri.Status == components.DemGetURIResponseStatusPaused) != tfPlan.Paused.ValueBool() {
+			return nil, ErrUriPausedNotUpdated
+		}
+		return uri, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error updating uri %s. err: %s", tfState.Id, err))
 		return
 	}
-	tfState.TestDefinitions = objectValue
-	resp.Diagnostics.Append(resp.State.Set(ctx, tfState)...)
+
+	// Save to Terraform state.
+	tfPlan.Id = tfState.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tfPlan)...)
 }
 
-func (r *uriResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var tfPlan, tfState *uriResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &tfPlan)...)
+func (r *uriResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var tfState uriResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &tfState)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var planOptions uriResourceOptions
-	d := tfPlan.Options.As(ctx, &planOptions, basetypes.ObjectAsOptions{})
+	deleteTimeout, d := tfState.Timeouts.Delete(ctx, expBackoffMaxElapsed)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var tcpOptions uriResourceTcpOptions
-	d = tfPlan.TcpOptions.As(ctx, &tcpOptions, basetypes.ObjectAsOptions{})
-	resp.Diagnostics.Append(d...)
-	if resp.Diagnostics.HasError() {
-		return
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete the Uri...
+	if _, err := r.client.Dem.DeleteURI(ctx, operations.DeleteURIRequest{EntityID: tfState.Id.ValueString()}); err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error deleting uri %s - %s", tfState.Id, err))
 	}
-	var testDefinitions uriResourceTestDefinitions
-	d = tfPlan.TestDefinitions.As(ctx, &testDefinitions, basetypes.ObjectAsOptions{})
-	resp.Diagnostics.Append(d...)
-	if resp.Diagnostics.HasError() {
-		return
+}
+
+func (r *uriResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readUri returns the uri with the given id.
+func (r *uriResource) readUri(ctx context.Context, id string) (*components.DemGetURIResponse, error) {
+	uriResp, err := r.client.Dem.GetURI(ctx, operations.GetURIRequest{EntityID: id})
+	if err != nil {
+		return nil, err
 	}
-	var planPlatformOptions uriResourcePlatformOptions
-	d = testDefinitions.PlatformOptions.As(ctx, &planPlatformOptions, basetypes.ObjectAsOptions{})
-	resp.Diagnostics.Append(d...)
-	if resp.Diagnostics.HasError() {
-		return
+	if uriResp.DemGetURIResponse == nil {
+		return nil, ErrNoUriDataReturned
+	}
+	return uriResp.DemGetURIResponse, nil
+}
+
+// setPaused pauses or resumes the monitoring of the uri.
+func (r *uriResource) setPaused(ctx context.Context, id string, paused bool) error {
+	if paused {
+		_, err := r.client.Dem.PauseURIMonitoring(ctx, operations.PauseURIMonitoringRequest{EntityID: id})
+		return err
+	}
+	_, err := r.client.Dem.UnpauseURIMonitoring(ctx, operations.UnpauseURIMonitoringRequest{EntityID: id})
+	return err
+}
+
+// uriReadRetry retries the read operation until the uri is returned, or the timeout elapses. Uri creates and updates
+// are eventually consistent, other errors aren't retried.
+func uriReadRetry(ctx context.Context, id string, timeout time.Duration, operation ReadOperation[*components.DemGetURIResponse]) (*components.DemGetURIResponse, error) {
+	return BackoffRetryWithTimeout(ctx, timeout, func() (*components.DemGetURIResponse, error) {
+		uri, err := operation(ctx, id)
+		if err != nil {
+			if isUriConsistencyError(err) {
+				return nil, err
+			}
+			return nil, backoff.Permanent(err)
+		}
+		if uri.ID == "" {
+			return nil, ErrUriEntityNoData
+		}
+		return uri, nil
+	})
+}
+
+// isUriConsistencyError returns true if the error is caused by a uri that isn't returned, or isn't updated yet.
+func isUriConsistencyError(err error) bool {
+	for _, consistencyErr := range []error{
+		ErrNoUriDataReturned,
+		ErrUriEntityNoData,
+		ErrUriNameNotUpdated,
+		ErrUriHostNotUpdated,
+		ErrUriTagsNotUpdated,
+		ErrUriPausedNotUpdated,
+		ErrUriSettingsNotUpdated,
+	} {
+		if errors.Is(err, consistencyErr) {
+			return true
+		}
 	}
+	return false
+}
+
+// uriTagsEqual returns true if the tags have the same keys and values, in any order.
+func uriTagsEqual(tags []components.CommonTag, others []components.CommonTag) bool {
+	tagStrings := func(tags []components.CommonTag) []string {
+		result := make([]string, 0, len(tags))
+		for _, t := range tags {
+			result = append(result, t.Key+"="+t.Value)
+		}
+		slices.Sort(result)
+		return result
+	}
+	return slices.Equal(tagStrings(tags), tagStrings(others))
+}
+
+// uriSettingsUpdated returns true if the availability settings of the uri have the settings that were sent.
+func uriSettingsUpdated(settings components.DemURIAvailabilityCheckSettings, sent components.DemURIAvailabilityCheckSettingsInput) bool {
+	if settings.TestFrom.Type != sent.TestFrom.Type ||
+		!uriSortedEqual(settings.TestFrom.Values, sent.TestFrom.Values) ||
+		settings.TestIntervalInSeconds != sent.TestIntervalInSeconds {
+		return false
+	}
+
+	if sent.Ping != nil && (settings.Ping == nil || settings.Ping.Enabled != sent.Ping.Enabled) {
+		return false
+	}
+	if sent.TCP != nil && (settings.TCP == nil ||
+		settings.TCP.Enabled != sent.TCP.Enabled ||
+		settings.TCP.Port != sent.TCP.Port ||
+		!typex.PtrEqual(settings.TCP.StringToSend, sent.TCP.StringToSend) ||
+		!typex.PtrEqual(settings.TCP.StringToExpect, sent.TCP.StringToExpect)) {
+		return false
+	}
+	if sent.DNS != nil && (settings.DNS == nil ||
+		settings.DNS.Enabled != sent.DNS.Enabled ||
+		settings.DNS.Nameserver != sent.DNS.Nameserver ||
+		!typex.PtrEqual(settings.DNS.Port, sent.DNS.Port) ||
+		settings.DNS.IPToExpect != sent.DNS.IPToExpect) {
+		return false
+	}
+	if sent.UDP != nil && (settings.UDP == nil ||
+		settings.UDP.Enabled != sent.UDP.Enabled ||
+		settings.UDP.Port != sent.UDP.Port ||
+		settings.UDP.StringToSend != sent.UDP.StringToSend ||
+		settings.UDP.StringToExpect != sent.UDP.StringToExpect) {
+		return false
+	}
+	if sent.PlatformOptions != nil {
+		if settings.PlatformOptions == nil ||
+			!uriSortedEqual(settings.PlatformOptions.ProbePlatforms, sent.PlatformOptions.ProbePlatforms) {
+			return false
+		}
+		if sent.PlatformOptions.TestFromAll != nil &&
+			!typex.PtrEqual(settings.PlatformOptions.TestFromAll, sent.PlatformOptions.TestFromAll) {
+			return false
+		}
+	}
+	return true
+}
+
+// uriSortedEqual returns true if the slices have the same elements, in any order.
+func uriSortedEqual[T cmp.Ordered](values []T, others []T) bool {
+	sortedValues := slices.Clone(values)
+	sortedOthers := slices.Clone(others)
+	slices.Sort(sortedValues)
+	slices.Sort(sortedOthers)
+	return slices.Equal(sortedValues, sortedOthers)
+}
+
+// uriInputFromPlan returns the uri of the plan for the DEM API.
+func uriInputFromPlan(ctx context.Context, tfPlan uriResourceModel, tagsConfig *entityTagsConfig, diags *diag.Diagnostics) components.DemURI {
+	var planOptions uriResourceOptions
+	diags.Append(tfPlan.Options.As(ctx, &planOptions, basetypes.ObjectAsOptions{})...)
+	var testDefinitions uriResourceTestDefinitions
+	diags.Append(tfPlan.TestDefinitions.As(ctx, &testDefinitions, basetypes.ObjectAsOptions{})...)
 	var locationOptions []uriResourceProbeLocation
-	d = testDefinitions.LocationOptions.ElementsAs(ctx, &locationOptions, false)
-	resp.Diagnostics.Append(d...)
-	if resp.Diagnostics.HasError() {
-		return
+	diags.Append(testDefinitions.LocationOptions.ElementsAs(ctx, &locationOptions, false)...)
+	tags := tagsConfig.merged(commonTagsFromSet(ctx, tfPlan.Tags, diags))
+	if diags.HasError() {
+		return components.DemURI{}
+	}
+
+	testFromType, err := stringToTestFromType(testDefinitions.TestFromLocation.ValueString())
+	if err != nil {
+		diags.AddError("Invalid Uri Configuration", err.Error())
+		return components.DemURI{}
 	}
 
-	// Create our input request.
-	updateInput := swoClient.UpdateUriInput{
-		Id:         tfState.Id.ValueString(),
-		Name:       tfPlan.Name.ValueString(),
-		IpOrDomain: tfPlan.Host.ValueString(),
-		PingOptions: &swoClient.UriPingOptionsInput{
+	settings := components.DemURIAvailabilityCheckSettingsInput{
+		TestFrom: components.DemTestFrom{
+			Type: testFromType,
+			Values: convertArray(locationOptions,
+				func(v uriResourceProbeLocation) string { return v.Value.ValueString() }),
+		},
+		TestIntervalInSeconds: float64(testDefinitions.TestIntervalInSeconds.ValueInt64()),
+		Ping: &components.Ping{
 			Enabled: planOptions.IsPingEnabled.ValueBool(),
 		},
-		TcpOptions: &swoClient.UriTcpOptionsInput{
+	}
+
+	if !tfPlan.TcpOptions.IsNull() || planOptions.IsTcpEnabled.ValueBool() {
+		var tcpOptions uriResourceTcpOptions
+		if !tfPlan.TcpOptions.IsNull() {
+			diags.Append(tfPlan.TcpOptions.As(ctx, &tcpOptions, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return components.DemURI{}
+			}
+		}
+		settings.TCP = &components.TCP{
 			Enabled:        planOptions.IsTcpEnabled.ValueBool(),
 			Port:           int(tcpOptions.Port.ValueInt64()),
 			StringToExpect: tcpOptions.StringToExpect.ValueStringPointer(),
 			StringToSend:   tcpOptions.StringToSend.ValueStringPointer(),
-		},
-		TestDefinitions: swoClient.UriTestDefinitionsInput{
-			PlatformOptions: &swoClient.ProbePlatformOptionsInput{
-				TestFromAll: planPlatformOptions.TestFromAll.ValueBoolPointer(),
-				ProbePlatforms: convertArray(planPlatformOptions.Platforms.Elements(),
-					func(s attr.Value) swoClient.ProbePlatform { return swoClient.ProbePlatform(attrValueToString(s)) }),
-			},
-			TestFrom: swoClient.ProbeLocationInput{
-				Type: swoClient.ProbeLocationType(testDefinitions.TestFromLocation.ValueString()),
-				Values: convertArray(locationOptions,
-					func(v uriResourceProbeLocation) string { return v.Value.ValueString() }),
-			},
-
-			TestIntervalInSeconds: swoClientTypes.TestIntervalInSeconds(int(testDefinitions.TestIntervalInSeconds.ValueInt64())),
-		},
+		}
 	}
 
-	var platforms []string
-	d = planPlatformOptions.Platforms.ElementsAs(ctx, &platforms, false)
-	resp.Diagnostics.Append(d...)
-	if resp.Diagnostics.HasError() {
-		return
+	if !tfPlan.DnsOptions.IsNull() {
+		var dnsOptions uriResourceDnsOptions
+		diags.Append(tfPlan.DnsOptions.As(ctx, &dnsOptions, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return components.DemURI{}
+		}
+		settings.DNS = &components.DNS{
+			Enabled:    planOptions.IsDnsEnabled.ValueBool(),
+			Nameserver: dnsOptions.Nameserver.ValueString(),
+			Port:       swov1.Int(int(dnsOptions.Port.ValueInt64())),
+			IPToExpect: dnsOptions.IpToExpect.ValueString(),
+		}
 	}
 
-	bUriToMatch, err := json.Marshal(map[string]interface{}{
-		"id":   updateInput.Id,
-		"name": updateInput.Name,
-		"host": updateInput.IpOrDomain,
-		"options": map[string]interface{}{
-			"isPingEnabled": updateInput.PingOptions.Enabled,
-			"isTcpEnabled":  updateInput.TcpOptions.Enabled,
-		},
-		"tcpOptions": map[string]interface{}{
-			"port":           updateInput.TcpOptions.Port,
-			"stringToExpect": updateInput.TcpOptions.StringToExpect,
-			"stringToSend":   updateInput.TcpOptions.StringToSend,
-		},
-		"testDefinitions": map[string]interface{}{
-			"testFromLocation":      updateInput.TestDefinitions.TestFrom.Type,
-			"testIntervalInSeconds": updateInput.TestDefinitions.TestIntervalInSeconds,
-			"platformOptions": map[string]interface{}{
-				"testFromAll": planPlatformOptions.TestFromAll.ValueBoolPointer(),
-				"platforms":   platforms,
-			},
-		},
-	})
+	if !tfPlan.UdpOptions.IsNull() {
+		var udpOptions uriResourceUdpOptions
+		diags.Append(tfPlan.UdpOptions.As(ctx, &udpOptions, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return components.DemURI{}
+		}
+		settings.UDP = &components.UDP{
+			Enabled:        planOptions.IsUdpEnabled.ValueBool(),
+			Port:           int(udpOptions.Port.ValueInt64()),
+			StringToExpect: udpOptions.StringToExpect.ValueString(),
+			StringToSend:   udpOptions.StringToSend.ValueString(),
+		}
+	}
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error marshaling uri result to match %s - %s", tfState.Id, err))
-		return
+	if !testDefinitions.PlatformOptions.IsNull() {
+		var planPlatformOptions uriResourcePlatformOptions
+		diags.Append(testDefinitions.PlatformOptions.As(ctx, &planPlatformOptions, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return components.DemURI{}
+		}
+		probePlatforms, err := mapPlatformsFromTerraform(planPlatformOptions.Platforms)
+		if err != nil {
+			diags.AddError("Invalid Uri Configuration", err.Error())
+			return components.DemURI{}
+		}
+		settings.PlatformOptions = &components.DemURIAvailabilityCheckSettingsInputPlatformOptions{
+			ProbePlatforms: probePlatforms,
+			TestFromAll:    planPlatformOptions.TestFromAll.ValueBoolPointer(),
+		}
 	}
 
-	var readUriResultToMatch swoClient.ReadUriResult
+	return components.DemURI{
+		Name:                      tfPlan.Name.ValueString(),
+		IPOrDomain:                tfPlan.Host.ValueString(),
+		AvailabilityCheckSettings: settings,
+		Tags:                      tags,
+	}
+}
 
-	err = json.Unmarshal(bUriToMatch, &readUriResultToMatch)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error unmarshaling uri result to match %s - %s", tfState.Id, err))
+// setUriValuesFromRead sets the values of the terraform state with the values returned from the Read request.
+func setUriValuesFromRead(ctx context.Context, uri *components.DemGetURIResponse, tagsConfig *entityTagsConfig, tfState *uriResourceModel, diags *diag.Diagnostics) {
+	settings := uri.AvailabilityCheckSettings
+	tfState.Host = types.StringValue(uri.IPOrDomain)
+	tfState.Name = types.StringValue(uri.Name)
+	tfState.Paused = types.BoolValue(uri.Status == components.DemGetURIResponseStatusPaused)
+	tfState.Tags, tfState.TagsAll = tagsConfig.stateTags(ctx, uri.Tags, tfState.Tags, diags)
+	if diags.HasError() {
 		return
 	}
 
-	// Update the Uri...
-	err = r.client.UriService().Update(ctx, updateInput)
-
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error updating uri %s. err: %s", tfState.Id, err))
+	// Options
+	optionsElement := uriResourceOptions{
+		IsPingEnabled: types.BoolValue(settings.Ping != nil && settings.Ping.Enabled),
+		IsTcpEnabled:  types.BoolValue(settings.TCP != nil && settings.TCP.Enabled),
+		IsDnsEnabled:  types.BoolValue(settings.DNS != nil && settings.DNS.Enabled),
+		IsUdpEnabled:  types.BoolValue(settings.UDP != nil && settings.UDP.Enabled),
+	}
+	tfOptions, d := types.ObjectValueFrom(ctx, UriResourceOptionsAttributeTypes(), optionsElement)
+	diags.Append(d...)
+	if diags.HasError() {
 		return
 	}
+	tfState.Options = tfOptions
 
-	// Updates are eventually consistent. Retry until the URI we read and the URI we are updating match.
-	_, err = BackoffRetry(ctx, func() (*swoClient.ReadUriResult, error) {
-		// Read the Uri...
-		uri, err := r.client.UriService().Read(ctx, tfState.Id.ValueString())
-
-		if err != nil {
-			return nil, backoff.Permanent(err)
+	// TcpOptions
+	tfState.TcpOptions = types.ObjectNull(UriTcpOptionsAttributeTypes())
+	if settings.TCP != nil {
+		tcpElement := uriResourceTcpOptions{
+			Port:           types.Int64Value(int64(settings.TCP.Port)),
+			StringToExpect: types.StringPointerValue(settings.TCP.StringToExpect),
+			StringToSend:   types.StringPointerValue(settings.TCP.StringToSend),
 		}
-
-		//Set unsupported values
-		readUriResultToMatch.Typename = uri.Typename
-		readUriResultToMatch.Options.IsHttpEnabled = uri.Options.IsHttpEnabled
-		readUriResultToMatch.HttpOptions = uri.HttpOptions
-		readUriResultToMatch.Tags = uri.Tags
-		readUriResultToMatch.TestDefinitions.LocationOptions = uri.TestDefinitions.LocationOptions
-
-		match := reflect.DeepEqual(&readUriResultToMatch, uri)
-
-		// Updated entity properties don't match, retry
-		if !match {
-			return nil, ErrNonMatchingEntities
+		tfState.TcpOptions, d = types.ObjectValueFrom(ctx, UriTcpOptionsAttributeTypes(), tcpElement)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
 		}
+	}
 
-		return uri, nil
-	})
+	// DnsOptions
+	tfState.DnsOptions = types.ObjectNull(UriDnsOptionsAttributeTypes())
+	if settings.DNS != nil {
+		dnsElement := uriResourceDnsOptions{
+			Nameserver: types.StringValue(settings.DNS.Nameserver),
+			Port:       types.Int64Null(),
+			IpToExpect: types.StringValue(settings.DNS.IPToExpect),
+		}
+		if settings.DNS.Port != nil {
+			dnsElement.Port = types.Int64Value(int64(*settings.DNS.Port))
+		}
+		tfState.DnsOptions, d = types.ObjectValueFrom(ctx, UriDnsOptionsAttributeTypes(), dnsElement)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+	}
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error updating uri %s. err: %s", tfState.Id, err))
-		return
+	// UdpOptions
+	tfState.UdpOptions = types.ObjectNull(UriUdpOptionsAttributeTypes())
+	if settings.UDP != nil {
+		udpElement := uriResourceUdpOptions{
+			Port:           types.Int64Value(int64(settings.UDP.Port)),
+			StringToExpect: types.StringValue(settings.UDP.StringToExpect),
+			StringToSend:   types.StringValue(settings.UDP.StringToSend),
+		}
+		tfState.UdpOptions, d = types.ObjectValueFrom(ctx, UriUdpOptionsAttributeTypes(), udpElement)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
 	}
 
-	// Save to Terraform state.
-	tfPlan.Id = tfState.Id
-	resp.Diagnostics.Append(resp.State.Set(ctx, &tfPlan)...)
-}
+	// TestDefinitions
+	testDefsElements := uriResourceTestDefinitions{
+		TestFromLocation:      types.StringValue(string(settings.TestFrom.Type)),
+		TestIntervalInSeconds: types.Int64Value(int64(settings.TestIntervalInSeconds)),
+		PlatformOptions:       types.ObjectNull(UriPlatformOptionsAttributeTypes()),
+	}
 
-func (r *uriResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var tfState uriResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &tfState)...)
-	if resp.Diagnostics.HasError() {
+	var locationOptsElements []attr.Value
+	for _, value := range settings.TestFrom.Values {
+		objectValue, d := types.ObjectValueFrom(ctx, UriProbeLocationAttributeTypes(), uriResourceProbeLocation{
+			Type:  types.StringValue(string(settings.TestFrom.Type)),
+			Value: types.StringValue(value),
+		})
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+		locationOptsElements = append(locationOptsElements, objectValue)
+	}
+	testDefsElements.LocationOptions, d = types.SetValueFrom(ctx,
+		types.ObjectType{AttrTypes: UriProbeLocationAttributeTypes()}, locationOptsElements)
+	diags.Append(d...)
+	if diags.HasError() {
 		return
 	}
 
-	// Delete the Uri...
-	if err := r.client.UriService().Delete(ctx, tfState.Id.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Client Error",
-			fmt.Sprintf("error deleting uri %s - %s", tfState.Id, err))
+	if settings.PlatformOptions != nil {
+		platforms, d := types.SetValueFrom(ctx, types.StringType, settings.PlatformOptions.ProbePlatforms)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+		platformElements := uriResourcePlatformOptions{
+			TestFromAll: types.BoolPointerValue(settings.PlatformOptions.TestFromAll),
+			Platforms:   platforms,
+		}
+		testDefsElements.PlatformOptions, d = types.ObjectValueFrom(ctx, UriPlatformOptionsAttributeTypes(), platformElements)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
 	}
-}
 
-func (r *uriResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tfState.TestDefinitions, d = types.ObjectValueFrom(ctx, UriTestDefAttributeTypes(), testDefsElements)
+	diags.Append(d...)
 }
@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/solarwinds/swo-sdk-go/swov1"
+	"github.com/solarwinds/swo-sdk-go/swov1/models/components"
 )
 
 func TestAccUriResource(t *testing.T) {
@@ -15,11 +20,13 @@ func TestAccUriResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccUriResourceConfig("test-acc test one [CREATE_TEST]"),
+				Config: testAccUriResourceConfig("test-acc test one [CREATE_TEST]", false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("swo_uri.test", "id"),
 					resource.TestCheckResourceAttr("swo_uri.test", "name", "test-acc test one [CREATE_TEST]"),
 					resource.TestCheckResourceAttr("swo_uri.test", "host", "example.com"),
+					//tag object order can be changed. Check for total number and nothing else.
+					resource.TestCheckResourceAttr("swo_uri.test", "tags.#", "2"),
 
 					resource.TestCheckResourceAttr("swo_uri.test", "options.is_ping_enabled", "false"),
 					resource.TestCheckResourceAttr("swo_uri.test", "options.is_tcp_enabled", "true"),
@@ -46,9 +53,66 @@ func TestAccUriResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccUriResourceConfig("test-acc test two [UPDATE_TEST]"),
+				Config: testAccUriResourceConfig("test-acc test two [UPDATE_TEST]", false),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("swo_uri.test", "name", "test-acc test two [UPDATE_TEST]"),
+					resource.TestCheckResourceAttr("swo_uri.test", "paused", "false"),
+				),
+			},
+			// Pause and resume testing
+			{
+				Config: testAccUriResourceConfig("test-acc test two [UPDATE_TEST]", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("swo_uri.test", "paused", "true"),
+				),
+			},
+			{
+				Config: testAccUriResourceConfig("test-acc test two [UPDATE_TEST]", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("swo_uri.test", "paused", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUriResourceDns(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUriResourceDnsConfig("test-acc dns [CREATE_TEST]", "192.0.2.10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("swo_uri.test", "id"),
+					resource.TestCheckResourceAttr("swo_uri.test", "options.is_ping_enabled", "false"),
+					resource.TestCheckResourceAttr("swo_uri.test", "options.is_tcp_enabled", "false"),
+					resource.TestCheckResourceAttr("swo_uri.test", "options.is_dns_enabled", "true"),
+					resource.TestCheckResourceAttr("swo_uri.test", "options.is_udp_enabled", "false"),
+
+					resource.TestCheckResourceAttr("swo_uri.test", "dns_options.nameserver", "8.8.8.8"),
+					resource.TestCheckResourceAttr("swo_uri.test", "dns_options.port", "53"),
+					resource.TestCheckResourceAttr("swo_uri.test", "dns_options.ip_to_expect", "192.0.2.10"),
+					resource.TestCheckResourceAttr("swo_uri.test", "timeouts.create", "5m"),
+					resource.TestCheckNoResourceAttr("swo_uri.test", "tcp_options"),
+					resource.TestCheckNoResourceAttr("swo_uri.test", "udp_options"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "swo_uri.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccUriResourceDnsConfig("test-acc dns [UPDATE_TEST]", "192.0.2.20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("swo_uri.test", "dns_options.ip_to_expect", "192.0.2.20"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -58,11 +122,23 @@ func TestAccUriResource(t *testing.T) {
 
 // Only supported regions (location_options.value) in Dev and Stage are NA
 // Production supports the following: NA, AS, SA, OC
-func testAccUriResourceConfig(name string) string {
+func testAccUriResourceConfig(name string, paused bool) string {
 	return providerConfig() + fmt.Sprintf(`
 	resource "swo_uri" "test" {
-		name = %q
-		host = "example.com"
+		name   = %q
+		host   = "example.com"
+		paused = %t
+
+		tags = [
+			{
+				key   = "team"
+				value = "payments"
+			},
+			{
+				key   = "env"
+				value = "prod"
+			}
+		]
 	
 		options = {
 			is_ping_enabled = false
@@ -92,5 +168,123 @@ func testAccUriResourceConfig(name string) string {
 				platforms     = ["AWS"]
 			}
 		}
-	}`, name)
+	}`, name, paused)
+}
+
+func testAccUriResourceDnsConfig(name string, ipToExpect string) string {
+	return providerConfig() + fmt.Sprintf(`
+	resource "swo_uri" "test" {
+		name = %q
+		host = "internal.example.com"
+
+		options = {
+			is_ping_enabled = false
+			is_tcp_enabled  = false
+			is_dns_enabled  = true
+		}
+
+		dns_options = {
+			nameserver   = "8.8.8.8"
+			ip_to_expect = %q
+		}
+
+		test_definitions = {
+			test_from_location = "REGION"
+
+			location_options = [
+				{
+					type  = "REGION"
+					value = "NA"
+				}
+			]
+
+			test_interval_in_seconds = 300
+		}
+
+		timeouts {
+			create = "5m"
+			update = "5m"
+		}
+	}`, name, ipToExpect)
+}
+
+func TestUriReadRetry(t *testing.T) {
+	errUnauthorized := errors.New("401 unauthorized")
+	tests := []struct {
+		name      string
+		errs      []error
+		wantErr   error
+		wantReads int
+	}{
+		{name: "not updated yet", errs: []error{ErrUriSettingsNotUpdated, ErrNoUriDataReturned}, wantReads: 3},
+		{name: "not retried", errs: []error{errUnauthorized}, wantErr: errUnauthorized, wantReads: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reads := 0
+			_, err := uriReadRetry(context.Background(), "uri-id", time.Minute,
+				func(_ context.Context, id string) (*components.DemGetURIResponse, error) {
+					reads++
+					if reads <= len(tt.errs) {
+						return nil, tt.errs[reads-1]
+					}
+					return &components.DemGetURIResponse{ID: id}, nil
+				})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("uriReadRetry() error = %v, want %v", err, tt.wantErr)
+			}
+			if reads != tt.wantReads {
+				t.Errorf("uriReadRetry() read the uri %d times, want %d", reads, tt.wantReads)
+			}
+		})
+	}
+}
+
+func TestUriSettingsUpdated(t *testing.T) {
+	sent := components.DemURIAvailabilityCheckSettingsInput{
+		TestFrom:              components.DemTestFrom{Type: components.TypeRegion, Values: []string{"NA", "EMEA"}},
+		TestIntervalInSeconds: 300,
+		Ping:                  &components.Ping{Enabled: true},
+		TCP:                   &components.TCP{Enabled: true, Port: 443, StringToSend: swov1.String("ping")},
+		PlatformOptions: &components.DemURIAvailabilityCheckSettingsInputPlatformOptions{
+			ProbePlatforms: []components.DemProbePlatform{components.DemProbePlatformAws},
+		},
+	}
+	updated := func() components.DemURIAvailabilityCheckSettings {
+		return components.DemURIAvailabilityCheckSettings{
+			TestFrom:              components.DemTestFrom{Type: components.TypeRegion, Values: []string{"EMEA", "NA"}},
+			TestIntervalInSeconds: 300,
+			Ping:                  &components.DemURIAvailabilityCheckSettingsPing{Enabled: true},
+			TCP: &components.DemURIAvailabilityCheckSettingsTCP{
+				Enabled: true, Port: 443, StringToSend: swov1.String("ping"),
+			},
+			PlatformOptions: &components.DemURIAvailabilityCheckSettingsPlatformOptions{
+				ProbePlatforms: []components.DemProbePlatform{components.DemProbePlatformAws},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*components.DemURIAvailabilityCheckSettings)
+		want   bool
+	}{
+		{name: "updated", modify: func(*components.DemURIAvailabilityCheckSettings) {}, want: true},
+		{name: "interval", modify: func(s *components.DemURIAvailabilityCheckSettings) { s.TestIntervalInSeconds = 60 }},
+		{name: "locations", modify: func(s *components.DemURIAvailabilityCheckSettings) { s.TestFrom.Values = []string{"NA"} }},
+		{name: "tcp port", modify: func(s *components.DemURIAvailabilityCheckSettings) { s.TCP.Port = 80 }},
+		{name: "ping", modify: func(s *components.DemURIAvailabilityCheckSettings) { s.Ping = nil }},
+		{name: "platforms", modify: func(s *components.DemURIAvailabilityCheckSettings) { s.PlatformOptions.ProbePlatforms = nil }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := updated()
+			tt.modify(&settings)
+			if got := uriSettingsUpdated(settings, sent); got != tt.want {
+				t.Errorf("uriSettingsUpdated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
 }
@@ -3,11 +3,15 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	swoClient "github.com/solarwinds/swo-client-go/pkg/client"
@@ -16,23 +20,33 @@ import (
 
 // uriResourceModel is the main resource structure
 type uriResourceModel struct {
-	Id              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Host            types.String `tfsdk:"host"`
-	Options         types.Object `tfsdk:"options"`          //uriResourceOptions
-	TcpOptions      types.Object `tfsdk:"tcp_options"`      //uriResourceTcpOptions
-	TestDefinitions types.Object `tfsdk:"test_definitions"` //uriResourceTestDefinitions
+	Id              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	Host            types.String   `tfsdk:"host"`
+	Tags            types.Set      `tfsdk:"tags"`
+	TagsAll         types.Set      `tfsdk:"tags_all"`
+	Paused          types.Bool     `tfsdk:"paused"`
+	Options         types.Object   `tfsdk:"options"`          //uriResourceOptions
+	TcpOptions      types.Object   `tfsdk:"tcp_options"`      //uriResourceTcpOptions
+	DnsOptions      types.Object   `tfsdk:"dns_options"`      //uriResourceDnsOptions
+	UdpOptions      types.Object   `tfsdk:"udp_options"`      //uriResourceUdpOptions
+	TestDefinitions types.Object   `tfsdk:"test_definitions"` //uriResourceTestDefinitions
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 type uriResourceOptions struct {
 	IsPingEnabled types.Bool `tfsdk:"is_ping_enabled"`
 	IsTcpEnabled  types.Bool `tfsdk:"is_tcp_enabled"`
+	IsDnsEnabled  types.Bool `tfsdk:"is_dns_enabled"`
+	IsUdpEnabled  types.Bool `tfsdk:"is_udp_enabled"`
 }
 
 func UriResourceOptionsAttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"is_ping_enabled": types.BoolType,
 		"is_tcp_enabled":  types.BoolType,
+		"is_dns_enabled":  types.BoolType,
+		"is_udp_enabled":  types.BoolType,
 	}
 }
 
@@ -50,6 +64,34 @@ func UriTcpOptionsAttributeTypes() map[string]attr.Type {
 	}
 }
 
+type uriResourceDnsOptions struct {
+	Nameserver types.String `tfsdk:"nameserver"`
+	Port       types.Int64  `tfsdk:"port"`
+	IpToExpect types.String `tfsdk:"ip_to_expect"`
+}
+
+func UriDnsOptionsAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"nameserver":   types.StringType,
+		"port":         types.Int64Type,
+		"ip_to_expect": types.StringType,
+	}
+}
+
+type uriResourceUdpOptions struct {
+	Port           types.Int64  `tfsdk:"port"`
+	StringToExpect types.String `tfsdk:"string_to_expect"`
+	StringToSend   types.String `tfsdk:"string_to_send"`
+}
+
+func UriUdpOptionsAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"port":             types.Int64Type,
+		"string_to_expect": types.StringType,
+		"string_to_send":   types.StringType,
+	}
+}
+
 type uriResourceTestDefinitions struct {
 	TestFromLocation      types.String `tfsdk:"test_from_location"`
 	LocationOptions       types.Set    `tfsdk:"location_options"` // uriResourceProbeLocation
@@ -90,7 +132,7 @@ func UriPlatformOptionsAttributeTypes() map[string]attr.Type {
 	}
 }
 
-func (r *uriResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *uriResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A terraform resource for managing Uri uptime checks.",
 		Attributes: map[string]schema.Attribute{
@@ -103,8 +145,11 @@ func (r *uriResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Description: "The IP address or host name to monitor.",
 				Required:    true,
 			},
+			"tags":     entityTagsAttribute(),
+			"tags_all": entityTagsAllAttribute(),
+			"paused":   entityPausedAttribute(),
 			"options": schema.SingleNestedAttribute{
-				Description: "The options for this Uri check.",
+				Description: "The options for this Uri check. Exactly one of ping, tcp, dns and udp monitoring must be enabled.",
 				Required:    true,
 				Attributes: map[string]schema.Attribute{
 					"is_ping_enabled": schema.BoolAttribute{
@@ -115,6 +160,18 @@ func (r *uriResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 						Description: "Whether or not to enable tcp monitoring.",
 						Required:    true,
 					},
+					"is_dns_enabled": schema.BoolAttribute{
+						Description: "Whether or not to enable dns monitoring. Requires `dns_options`.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"is_udp_enabled": schema.BoolAttribute{
+						Description: "Whether or not to enable udp monitoring. Requires `udp_options`.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
 				},
 			},
 			"tcp_options": schema.SingleNestedAttribute{
@@ -135,6 +192,51 @@ func (r *uriResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 					},
 				},
 			},
+			"dns_options": schema.SingleNestedAttribute{
+				Description: "The dns options for this Uri check. The host is resolved with the nameserver, " +
+					"and the check fails when the answer doesn't contain the expected IP address.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"nameserver": schema.StringAttribute{
+						Description: "The IP address or host name of the nameserver to query.",
+						Required:    true,
+					},
+					"port": schema.Int64Attribute{
+						Description: "The port of the nameserver. Default is 53.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(53),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 65535),
+						},
+					},
+					"ip_to_expect": schema.StringAttribute{
+						Description: "The IP address to expect in the dns answer.",
+						Required:    true,
+					},
+				},
+			},
+			"udp_options": schema.SingleNestedAttribute{
+				Description: "The udp options for this Uri check.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"port": schema.Int64Attribute{
+						Description: "The port to use for udp monitoring.",
+						Required:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 65535),
+						},
+					},
+					"string_to_expect": schema.StringAttribute{
+						Description: "The string to expect in the response.",
+						Required:    true,
+					},
+					"string_to_send": schema.StringAttribute{
+						Description: "The string to send in the request.",
+						Required:    true,
+					},
+				},
+			},
 			"test_definitions": schema.SingleNestedAttribute{
 				Description: "The test definitions for this Uri check.",
 				Required:    true,
@@ -197,5 +299,13 @@ func (r *uriResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
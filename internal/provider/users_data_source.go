@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const listOrganizationMembersQuery = `query listOrganizationMembers($filter: OrganizationMemberFilter) {
+  user {
+    currentOrganization {
+      members(filter: $filter) {
+        role
+        user {
+          id
+          email
+          firstName
+          lastName
+        }
+      }
+    }
+  }
+}`
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// Defines the data source implementation.
+type usersDataSource struct {
+	gqlClient *gqlClient
+}
+
+type organizationMember struct {
+	Role string `json:"role"`
+	User struct {
+		Id        string  `json:"id"`
+		Email     string  `json:"email"`
+		FirstName *string `json:"firstName"`
+		LastName  *string `json:"lastName"`
+	} `json:"user"`
+}
+
+// listOrganizationMembers returns the members of the current organization, optionally filtered by role.
+func listOrganizationMembers(ctx context.Context, client *gqlClient, role string) ([]organizationMember, error) {
+	type listOrganizationMembersResponse struct {
+		User struct {
+			CurrentOrganization struct {
+				Members []organizationMember `json:"members"`
+			} `json:"currentOrganization"`
+		} `json:"user"`
+	}
+
+	var filter map[string]any
+	if role != "" {
+		filter = map[string]any{"role": role}
+	}
+
+	resp, err := gqlDo[listOrganizationMembersResponse](ctx, client, "listOrganizationMembers", listOrganizationMembersQuery,
+		map[string]any{"filter": filter})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.User.CurrentOrganization.Members, nil
+}
+
+// userEmailsById returns the current email address of every member of the organization.
+func userEmailsById(ctx context.Context, client *gqlClient) (map[string]string, error) {
+	members, err := listOrganizationMembers(ctx, client, "")
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make(map[string]string, len(members))
+	for _, m := range members {
+		emails[m.User.Id] = m.User.Email
+	}
+	return emails, nil
+}
+
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(providerClients)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", "")
+		return
+	}
+	d.gqlClient = clients.GqlClient
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var tfConfig usersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tfConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := listOrganizationMembers(ctx, d.gqlClient, tfConfig.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("error reading users. error: %s", err))
+		return
+	}
+
+	var users []userModel
+	for _, m := range members {
+		if !tfConfig.Email.IsNull() && !strings.EqualFold(m.User.Email, tfConfig.Email.ValueString()) {
+			continue
+		}
+		users = append(users, userModel{
+			Id:        types.StringValue(m.User.Id),
+			Email:     types.StringValue(m.User.Email),
+			FirstName: types.StringPointerValue(m.User.FirstName),
+			LastName:  types.StringPointerValue(m.User.LastName),
+			Role:      types.StringValue(m.Role),
+		})
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Email.ValueString() < users[j].Email.ValueString()
+	})
+
+	tfUsers, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: UserAttributeTypes()}, users)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tfConfig.Users = tfUsers
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tfConfig)...)
+}
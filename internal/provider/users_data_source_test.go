@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccUsersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.swo_users.admins", "users.0.id"),
+					resource.TestCheckResourceAttr("data.swo_users.admins", "users.0.role", "ADMIN"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsersDataSourceConfig() string {
+	return providerConfig() + `
+	data "swo_users" "admins" {
+		role = "ADMIN"
+	}`
+}
+
+func newTestMembersClient(t *testing.T, emails map[string]string) *gqlClient {
+	return newTestGqlClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var members []map[string]any
+		for id, email := range emails {
+			members = append(members, map[string]any{
+				"role": "MEMBER",
+				"user": map[string]any{"id": id, "email": email},
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"user": map[string]any{
+					"currentOrganization": map[string]any{"members": members},
+				},
+			},
+		})
+	})
+}
+
+func TestResolveEmailAddresses(t *testing.T) {
+	r := &notificationResource{gqlClient: newTestMembersClient(t, map[string]string{"1": "one@host.com"})}
+	userId := "1"
+
+	settings, err := r.resolveEmailAddresses(context.Background(), clientEmail{
+		Addresses: []clientEmailAddress{{Id: &userId}, {Email: "other@host.com"}},
+	})
+	if err != nil {
+		t.Fatalf("resolveEmailAddresses() error = %s", err)
+	}
+	addresses := settings.(clientEmail).Addresses
+	if addresses[0].Email != "one@host.com" || addresses[1].Email != "other@host.com" {
+		t.Errorf("resolveEmailAddresses() = %+v", addresses)
+	}
+
+	unknownId := "2"
+	_, err = r.resolveEmailAddresses(context.Background(), clientEmail{
+		Addresses: []clientEmailAddress{{Id: &unknownId}},
+	})
+	if !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("resolveEmailAddresses() error = %v, want %v", err, ErrUserNotFound)
+	}
+}
+
+func TestSyncEmailAddresses(t *testing.T) {
+	r := &notificationResource{gqlClient: newTestMembersClient(t, map[string]string{
+		"1": "one@host.com",
+		"2": "two-new@host.com",
+	})}
+	one, two := "1", "2"
+
+	var settings any = clientEmail{
+		Addresses: []clientEmailAddress{
+			{Id: &one, Email: "one@host.com"},
+			{Id: &two, Email: "two-old@host.com"},
+		},
+	}
+	synced, err := r.syncEmailAddresses(context.Background(), &settings, map[string]bool{"1": true, "2": true})
+	if err != nil {
+		t.Fatalf("syncEmailAddresses() error = %s", err)
+	}
+
+	addresses := (*synced).(clientEmail).Addresses
+	if addresses[0].Email != "" {
+		t.Errorf("expected the current email of user 1 to be cleared, got %q", addresses[0].Email)
+	}
+	if addresses[1].Email != "two-old@host.com" {
+		t.Errorf("expected the stale email of user 2 to be kept, got %q", addresses[1].Email)
+	}
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/solarwinds/terraform-provider-swo/internal/validators"
+)
+
+var organizationRoles = []string{
+	"OWNER",
+	"ADMIN",
+	"MEMBER",
+	"BILLING",
+	"RESTRICTED_MEMBER",
+	"VIEWER",
+}
+
+// The main Users Data Source model that is derived from the schema.
+type usersDataSourceModel struct {
+	Email types.String `tfsdk:"email"`
+	Role  types.String `tfsdk:"role"`
+	Users types.List   `tfsdk:"users"` //userModel
+}
+
+type userModel struct {
+	Id        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Role      types.String `tfsdk:"role"`
+}
+
+func UserAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"email":      types.StringType,
+		"first_name": types.StringType,
+		"last_name":  types.StringType,
+		"role":       types.StringType,
+	}
+}
+
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A terraform data source for looking up the users of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Description: "Only return the user with this email address. The comparison is case insensitive.",
+				Optional:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Only return users with this organization role. " +
+					"Valid values are [`OWNER`|`ADMIN`|`MEMBER`|`BILLING`|`RESTRICTED_MEMBER`|`VIEWER`].",
+				Optional: true,
+				Validators: []validator.String{
+					validators.OneOf(organizationRoles...),
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The users matching the filters, ordered by email address.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The user id.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user.",
+							Computed:    true,
+						},
+						"first_name": schema.StringAttribute{
+							Description: "The first name of the user.",
+							Computed:    true,
+						},
+						"last_name": schema.StringAttribute{
+							Description: "The last name of the user.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The organization role of the user.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -66,9 +66,27 @@ func sliceToStringList[T any](
 	return list
 }
 
+// stringValueOrNull converts empty strings to a null value.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
 func attrValueToString(val attr.Value) string {
 	if val == nil {
 		return ""
 	}
 	return strings.Trim(val.String(), "\"")
 }
+
+// isFullyKnown checks whether the value and all the values nested in it are known.
+func isFullyKnown(ctx context.Context, val attr.Value) bool {
+	tfValue, err := val.ToTerraformValue(ctx)
+	return err == nil && tfValue.IsFullyKnown()
+}
+
+func toStringValue(s string) attr.Value {
+	return types.StringValue(s)
+}
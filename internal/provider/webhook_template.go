@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	webhookContentTypeJson = "application/json"
+	webhookContentTypeForm = "application/x-www-form-urlencoded"
+	webhookContentTypeText = "text/plain"
+
+	// A JSON number that is valid both as a bare value and inside a string, so a placeholder
+	// can be substituted without knowing where it is used in the template.
+	webhookTemplateSentinel = "-0.%d7182818284590452353602874e-7713"
+)
+
+var (
+	webhookTemplateVariableRegex = regexp.MustCompile(`\{\{\s*([^{}]*?)\s*\}\}`)
+
+	// The alert variables of webhook payload templates that are documented in the SWO alert notification
+	// settings. The API doesn't list the variables, so this list may not be complete.
+	webhookTemplateVariables = []string{
+		"alert.id",
+		"alert.name",
+		"alert.description",
+		"alert.severity",
+		"alert.status",
+		"alert.url",
+		"alert.runbookLink",
+		"alert.triggeredAt",
+		"alert.resetAt",
+		"condition.metricName",
+		"condition.threshold",
+		"condition.value",
+		"entity.id",
+		"entity.name",
+		"entity.type",
+		"entity.url",
+		"organization.id",
+		"organization.name",
+	}
+)
+
+// webhookTemplateVariablesIn returns the variable names referenced by the template, in order of appearance.
+func webhookTemplateVariablesIn(template string) []string {
+	var variables []string
+	for _, match := range webhookTemplateVariableRegex.FindAllStringSubmatch(template, -1) {
+		variables = append(variables, match[1])
+	}
+	return variables
+}
+
+// substituteWebhookTemplate replaces every template variable with a unique JSON number. The returned
+// placeholders map each number back to the original variable reference.
+func substituteWebhookTemplate(template string) (string, map[string]string, bool) {
+	placeholders := map[string]string{}
+	idx := 0
+	ok := true
+
+	rendered := webhookTemplateVariableRegex.ReplaceAllStringFunc(template, func(ref string) string {
+		sentinel := fmt.Sprintf(webhookTemplateSentinel, idx)
+		idx++
+		if strings.Contains(template, sentinel) {
+			ok = false
+		}
+		placeholders[sentinel] = ref
+		return sentinel
+	})
+
+	return rendered, placeholders, ok
+}
+
+// normalizeWebhookJsonTemplate removes insignificant whitespace from a JSON template while keeping
+// the key order and the variable references untouched. The template is returned unchanged when it
+// doesn't render to valid JSON.
+func normalizeWebhookJsonTemplate(template string) string {
+	rendered, placeholders, ok := substituteWebhookTemplate(template)
+	if !ok {
+		return template
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(rendered)); err != nil {
+		return template
+	}
+
+	normalized := buf.String()
+	for sentinel, ref := range placeholders {
+		normalized = strings.ReplaceAll(normalized, sentinel, ref)
+	}
+	return normalized
+}
+
+// isWebhookJsonContentType checks whether the sibling content_type attribute is JSON. A null
+// content type is treated as JSON because it is the default used by the webhook integration.
+func isWebhookJsonContentType(ctx context.Context, config tfsdk.Config, attrPath path.Path, diags *diag.Diagnostics) bool {
+	contentType := types.String{}
+	d := config.GetAttribute(ctx, attrPath.ParentPath().AtName("content_type"), &contentType)
+	diags.Append(d...)
+	if d.HasError() {
+		return false
+	}
+	return contentType.IsNull() || contentType.ValueString() == webhookContentTypeJson
+}
+
+// webhookTemplateValidator warns about payload template variables that aren't documented and, for JSON
+// content types, checks that the template renders to valid JSON.
+type webhookTemplateValidator struct{}
+
+func (v webhookTemplateValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v webhookTemplateValidator) MarkdownDescription(_ context.Context) string {
+	return "template variables should be documented alert variables, and the template must render to valid JSON when the content type is JSON"
+}
+
+func (v webhookTemplateValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	template := req.ConfigValue.ValueString()
+
+	// The variables aren't listed by the API, so variables that aren't documented are only reported.
+	for _, variable := range webhookTemplateVariablesIn(template) {
+		if !slices.Contains(webhookTemplateVariables, variable) {
+			resp.Diagnostics.AddAttributeWarning(req.Path, "Unknown Webhook Template Variable",
+				fmt.Sprintf("The template variable %q isn't one of the documented alert variables [%s], "+
+					"check that SWO substitutes it.", variable, strings.Join(webhookTemplateVariables, ", ")))
+		}
+	}
+
+	if !isWebhookJsonContentType(ctx, req.Config, req.Path, &resp.Diagnostics) {
+		return
+	}
+
+	rendered, _, _ := substituteWebhookTemplate(template)
+	if !json.Valid([]byte(rendered)) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Webhook Template",
+			"The template does not render to valid JSON, which is required when the content type is `"+webhookContentTypeJson+"`.")
+	}
+}
+
+// webhookTemplateType is the type of a webhook payload template. JSON templates that only differ in
+// insignificant whitespace are semantically equal, so the configured template is kept in the state.
+type webhookTemplateType struct {
+	basetypes.StringType
+}
+
+var _ basetypes.StringTypable = webhookTemplateType{}
+
+func (t webhookTemplateType) String() string {
+	return "webhookTemplateType"
+}
+
+func (t webhookTemplateType) Equal(o attr.Type) bool {
+	other, ok := o.(webhookTemplateType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t webhookTemplateType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return webhookTemplateValue{StringValue: in}, nil
+}
+
+func (t webhookTemplateType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return webhookTemplateValue{StringValue: stringValue}, nil
+}
+
+func (t webhookTemplateType) ValueType(_ context.Context) attr.Value {
+	return webhookTemplateValue{}
+}
+
+// webhookTemplateValue is the value of a webhook payload template.
+type webhookTemplateValue struct {
+	basetypes.StringValue
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = webhookTemplateValue{}
+
+func webhookTemplateValueOrNull(template string) webhookTemplateValue {
+	return webhookTemplateValue{StringValue: stringValueOrNull(template)}
+}
+
+func (v webhookTemplateValue) Type(_ context.Context) attr.Type {
+	return webhookTemplateType{}
+}
+
+func (v webhookTemplateValue) Equal(o attr.Value) bool {
+	other, ok := o.(webhookTemplateValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true when both templates are equal once insignificant whitespace is removed
+// from them. Templates that don't render to valid JSON are compared as they are.
+func (v webhookTemplateValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(webhookTemplateValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T.", v, newValuable))
+		return false, diags
+	}
+
+	return normalizeWebhookJsonTemplate(v.ValueString()) == normalizeWebhookJsonTemplate(newValue.ValueString()), diags
+}
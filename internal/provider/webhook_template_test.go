@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNormalizeWebhookJsonTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{
+			name:     "whitespace is removed and key order is kept",
+			template: "{\n  \"text\": \"{{alert.name}} is {{ alert.status }}\",\n  \"a\": 1\n}",
+			want:     `{"text":"{{alert.name}} is {{ alert.status }}","a":1}`,
+		},
+		{
+			name:     "bare variables",
+			template: `{ "value": {{condition.value}}, "threshold": {{condition.threshold}} }`,
+			want:     `{"value":{{condition.value}},"threshold":{{condition.threshold}}}`,
+		},
+		{
+			name:     "invalid json is unchanged",
+			template: `{ "value": {{condition.value}}ms }`,
+			want:     `{ "value": {{condition.value}}ms }`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeWebhookJsonTemplate(tt.template); got != tt.want {
+				t.Errorf("normalizeWebhookJsonTemplate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubstituteWebhookTemplate(t *testing.T) {
+	rendered, placeholders, ok := substituteWebhookTemplate(`{"name": "{{alert.name}}", "value": {{condition.value}}}`)
+	if !ok {
+		t.Fatal("substituteWebhookTemplate() reported a sentinel collision")
+	}
+	if !json.Valid([]byte(rendered)) {
+		t.Errorf("rendered template is not valid JSON: %s", rendered)
+	}
+	if len(placeholders) != 2 {
+		t.Errorf("expected 2 placeholders, got %d", len(placeholders))
+	}
+}
+
+func TestWebhookTemplateVariablesIn(t *testing.T) {
+	got := webhookTemplateVariablesIn(`{{alert.name}} {{ entity.name }} {{unknown}}`)
+	want := []string{"alert.name", "entity.name", "unknown"}
+	if len(got) != len(want) {
+		t.Fatalf("webhookTemplateVariablesIn() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("webhookTemplateVariablesIn()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWebhookTemplateValidator(t *testing.T) {
+	ctx := context.Background()
+	tfSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"content_type":     schema.StringAttribute{Optional: true},
+			"payload_template": schema.StringAttribute{Optional: true},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		template     string
+		wantErrors   int
+		wantWarnings int
+	}{
+		{
+			name:     "valid template",
+			template: `{"name": "{{alert.name}}"}`,
+		},
+		{
+			name:         "undocumented variable in valid json",
+			template:     `{"name": "{{alert.unknown}}"}`,
+			wantWarnings: 1,
+		},
+		{
+			name:         "undocumented variable and invalid json",
+			template:     `{"name": {{alert.unknown}}ms}`,
+			wantErrors:   1,
+			wantWarnings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.Config{Schema: tfSchema, Raw: tftypes.NewValue(tfSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"content_type":     tftypes.NewValue(tftypes.String, webhookContentTypeJson),
+				"payload_template": tftypes.NewValue(tftypes.String, tt.template),
+			})}
+			req := validator.StringRequest{
+				Path:        path.Root("payload_template"),
+				ConfigValue: types.StringValue(tt.template),
+				Config:      config,
+			}
+			var resp validator.StringResponse
+			webhookTemplateValidator{}.ValidateString(ctx, req, &resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.wantErrors {
+				t.Errorf("ValidateString() returned %d errors, want %d: %v", got, tt.wantErrors, resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != tt.wantWarnings {
+				t.Errorf("ValidateString() returned %d warnings, want %d: %v", got, tt.wantWarnings, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestWebhookTemplateSettingsFromRead(t *testing.T) {
+	ctx := context.Background()
+	template := "{\n  \"text\": \"{{alert.name}} is {{alert.status}}\",\n  \"value\": {{condition.value}}\n}\n"
+
+	tests := []struct {
+		name            string
+		webhook         notificationSettingsWebhook
+		settings        clientWebhook
+		wantContentType types.String
+		wantTemplate    string
+	}{
+		{
+			name:            "the default content type isn't stored when it wasn't set",
+			webhook:         notificationSettingsWebhook{ContentType: types.StringNull()},
+			settings:        clientWebhook{ContentType: webhookContentTypeJson},
+			wantContentType: types.StringNull(),
+		},
+		{
+			name:            "a content type that was set is stored",
+			webhook:         notificationSettingsWebhook{ContentType: types.StringValue(webhookContentTypeJson)},
+			settings:        clientWebhook{ContentType: webhookContentTypeJson},
+			wantContentType: types.StringValue(webhookContentTypeJson),
+		},
+		{
+			name:            "a content type that was changed is stored",
+			webhook:         notificationSettingsWebhook{ContentType: types.StringNull()},
+			settings:        clientWebhook{ContentType: webhookContentTypeText},
+			wantContentType: types.StringValue(webhookContentTypeText),
+		},
+		{
+			name:            "the configured template is kept",
+			webhook:         notificationSettingsWebhook{PayloadTemplate: webhookTemplateValueOrNull(template)},
+			settings:        clientWebhook{ContentType: webhookContentTypeJson, PayloadTemplate: normalizeWebhookJsonTemplate(template)},
+			wantContentType: types.StringNull(),
+			wantTemplate:    template,
+		},
+		{
+			name:            "a changed template is normalized",
+			webhook:         notificationSettingsWebhook{PayloadTemplate: webhookTemplateValueOrNull(template)},
+			settings:        clientWebhook{PayloadTemplate: `{ "text": "{{alert.name}}" }`},
+			wantContentType: types.StringNull(),
+			wantTemplate:    `{"text":"{{alert.name}}"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			tt.webhook.setTemplateSettings(&tt.settings, ctx, &diags)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if !tt.webhook.ContentType.Equal(tt.wantContentType) {
+				t.Errorf("content_type = %s, want %s", tt.webhook.ContentType, tt.wantContentType)
+			}
+			if tt.webhook.PayloadTemplate.ValueString() != tt.wantTemplate {
+				t.Errorf("payload_template = %q, want %q", tt.webhook.PayloadTemplate.ValueString(), tt.wantTemplate)
+			}
+		})
+	}
+}
@@ -20,7 +20,8 @@ import (
 
 const (
 	websiteExpBackoffMaxInterval = 30 * time.Second
-	websiteExpBackoffMaxElapsed  = 2 * time.Minute
+	// The default timeout of website operations, which can be changed with the 'timeouts' block.
+	websiteDefaultTimeout = 2 * time.Minute
 )
 
 var (
@@ -31,6 +32,7 @@ var (
 	ErrRUMSettingsNotUpdated          = errors.New("RUM settings not yet updated")
 	ErrWebsiteNameNotUpdated          = errors.New("website name not yet updated")
 	ErrWebsiteURLNotUpdated           = errors.New("website URL not yet updated")
+	ErrWebsitePausedNotUpdated        = errors.New("website paused status not yet updated")
 	ErrUnsupportedOperator            = errors.New("unsupported operator")
 	ErrUnsupportedProtocol            = errors.New("unsupported protocol")
 	ErrUnsupportedPlatform            = errors.New("unsupported platform")
@@ -72,6 +74,7 @@ var (
 	_ resource.Resource                = &websiteResource{}
 	_ resource.ResourceWithConfigure   = &websiteResource{}
 	_ resource.ResourceWithImportState = &websiteResource{}
+	_ resource.ResourceWithModifyPlan  = &websiteResource{}
 )
 
 func NewWebsiteResource() resource.Resource {
@@ -80,6 +83,7 @@ func NewWebsiteResource() resource.Resource {
 
 type websiteResource struct {
 	client *swov1.Swo
+	tags   *entityTagsConfig
 }
 
 func (r *websiteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -89,6 +93,11 @@ func (r *websiteResource) Metadata(ctx context.Context, req resource.MetadataReq
 func (r *websiteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, _ := req.ProviderData.(providerClients)
 	r.client = client.SwoV1Client
+	r.tags = client.EntityTags
+}
+
+func (r *websiteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.tags.modifyPlan(ctx, req, resp)
 }
 
 func (r *websiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -98,22 +107,23 @@ func (r *websiteResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	// Build the website input
-	var tags []websiteTag
-	d := tfPlan.Tags.ElementsAs(ctx, &tags, false)
+	createTimeout, d := tfPlan.Timeouts.Create(ctx, websiteDefaultTimeout)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Build the website input
+	tags := r.tags.merged(commonTagsFromSet(ctx, tfPlan.Tags, &resp.Diagnostics))
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	createInput := components.DemWebsite{
 		Name: tfPlan.Name.ValueString(),
 		URL:  tfPlan.Url.ValueString(),
-		Tags: convertArray(tags, func(e websiteTag) components.CommonTag {
-			return components.CommonTag{
-				Key:   e.Key.ValueString(),
-				Value: e.Value.ValueString(),
-			}
-		}),
+		Tags: tags,
 	}
 
 	// Parse monitoring configuration
@@ -168,67 +178,71 @@ func (r *websiteResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	// Set the ID from the creation response
+	// Set the ID from the creation response, and record it before the website is confirmed.
 	tfPlan.Id = types.StringValue(res.CommonEntityID.GetID())
+	createThenConfirm(ctx, req, resp, tfPlan.Id.ValueString(), func() {
+		// A website is created with active monitoring. An error leaves the created website tainted.
+		if tfPlan.Paused.ValueBool() {
+			if err := r.setPaused(ctx, tfPlan.Id.ValueString(), true); err != nil {
+				resp.Diagnostics.AddError("Client Error",
+					fmt.Sprintf("error pausing website '%s' - error: %s", tfPlan.Name.ValueString(), err))
+			}
+		}
 
-	// Set computed monitoring options based on what was configured
-	userMonitoringOptions := monitoringOptions{
-		IsAvailabilityActive: types.BoolValue(!tfMonitoring.Availability.IsNull()),
-		IsRumActive:          types.BoolValue(!tfMonitoring.Rum.IsNull()),
-	}
-
-	userOptions, dOpts := types.ObjectValueFrom(ctx, MonitoringOptionsAttributeTypes(), userMonitoringOptions)
-	resp.Diagnostics.Append(dOpts...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	tfMonitoring.Options = userOptions
-
-	// Get the created website to get computed fields
-	websiteResp, err := r.client.Dem.GetWebsite(ctx, operations.GetWebsiteRequest{
-		EntityID: tfPlan.Id.ValueString(),
-	})
+		// Set computed monitoring options based on what was configured
+		userMonitoringOptions := monitoringOptions{
+			IsAvailabilityActive: types.BoolValue(!tfMonitoring.Availability.IsNull()),
+			IsRumActive:          types.BoolValue(!tfMonitoring.Rum.IsNull()),
+		}
 
-	// Update RUM with computed snippet field
-	if !tfMonitoring.Rum.IsNull() {
-		var rum rumMonitoring
-		d = tfMonitoring.Rum.As(ctx, &rum, basetypes.ObjectAsOptions{})
-		resp.Diagnostics.Append(d...)
+		userOptions, dOpts := types.ObjectValueFrom(ctx, MonitoringOptionsAttributeTypes(), userMonitoringOptions)
+		resp.Diagnostics.Append(dOpts...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		tfMonitoring.Options = userOptions
 
-		// Set RUM snippet from server response if available
-		if err == nil && websiteResp.DemGetWebsiteResponse != nil &&
-			websiteResp.DemGetWebsiteResponse.Rum != nil &&
-			websiteResp.DemGetWebsiteResponse.Rum.Snippet != nil {
-			rum.Snippet = types.StringValue(*websiteResp.DemGetWebsiteResponse.Rum.Snippet)
-		} else {
-			rum.Snippet = types.StringValue("")
+		// Creates are eventually consistent. Get the created website to get computed fields.
+		website, err := websiteReadRetry(ctx, tfPlan.Id.ValueString(), createTimeout, r.readWebsite)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("error reading website '%s' after create - error: %s", tfPlan.Name.ValueString(), err))
+			return
 		}
 
-		rumObject, dRum := types.ObjectValueFrom(ctx, RumMonitoringAttributeTypes(), rum)
-		resp.Diagnostics.Append(dRum...)
+		// Update RUM with computed snippet field
+		if !tfMonitoring.Rum.IsNull() {
+			var rum rumMonitoring
+			d = tfMonitoring.Rum.As(ctx, &rum, basetypes.ObjectAsOptions{})
+			resp.Diagnostics.Append(d...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			// Set RUM snippet from server response if available
+			if website.Rum != nil && website.Rum.Snippet != nil {
+				rum.Snippet = types.StringValue(*website.Rum.Snippet)
+			} else {
+				rum.Snippet = types.StringValue("")
+			}
+
+			rumObject, dRum := types.ObjectValueFrom(ctx, RumMonitoringAttributeTypes(), rum)
+			resp.Diagnostics.Append(dRum...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			tfMonitoring.Rum = rumObject
+		}
+
+		monitoringObject, dMonitor := types.ObjectValueFrom(ctx, WebsiteMonitoringAttributeTypes(), tfMonitoring)
+		resp.Diagnostics.Append(dMonitor...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		tfMonitoring.Rum = rumObject
-	}
+		tfPlan.Monitoring = monitoringObject
 
-	monitoringObject, dMonitor := types.ObjectValueFrom(ctx, WebsiteMonitoringAttributeTypes(), tfMonitoring)
-	resp.Diagnostics.Append(dMonitor...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	tfPlan.Monitoring = monitoringObject
-
-	// Warn if we couldn't read the website after creation
-	if err != nil {
-		resp.Diagnostics.AddWarning("Client Error",
-			fmt.Sprintf("error reading website after create '%s' - error: %s", tfPlan.Name.ValueString(), err))
-	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, tfPlan)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, tfPlan)...)
+	})
 }
 
 func (r *websiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -238,25 +252,16 @@ func (r *websiteResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	// Read operation for retry logic
-	readOperation := func(ctx context.Context, id string) (*components.DemGetWebsiteResponse, error) {
-		websiteResp, err := r.client.Dem.GetWebsite(ctx, operations.GetWebsiteRequest{
-			EntityID: id,
-		})
-
-		if err != nil {
-			return nil, err
-		}
-
-		if websiteResp.DemGetWebsiteResponse == nil {
-			return nil, ErrNoWebsiteDataReturned
-		}
-
-		return websiteResp.DemGetWebsiteResponse, nil
+	readTimeout, d := tfState.Timeouts.Read(ctx, websiteDefaultTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	// GET website data with retry
-	website, err := websiteReadRetry(ctx, tfState.Id.ValueString(), readOperation)
+	website, err := websiteReadRetry(ctx, tfState.Id.ValueString(), readTimeout, r.readWebsite)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
 			fmt.Sprintf("error reading website %s. error: %s", tfState.Name, err))
@@ -266,29 +271,11 @@ func (r *websiteResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// Update basic website fields
 	tfState.Url = types.StringValue(website.URL)
 	tfState.Name = types.StringValue(website.Name)
-	var tagElements []attr.Value
-	for _, x := range website.Tags {
-		objectValue, d := types.ObjectValueFrom(
-			ctx,
-			WebsiteTagAttributeTypes(),
-			websiteTag{
-				Key:   types.StringValue(x.Key),
-				Value: types.StringValue(x.Value),
-			},
-		)
-
-		resp.Diagnostics.Append(d...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		tagElements = append(tagElements, objectValue)
-	}
-	tags, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: WebsiteTagAttributeTypes()}, tagElements)
-	resp.Diagnostics.Append(d...)
+	tfState.Paused = types.BoolValue(website.Status == components.DemGetWebsiteResponseStatusPaused)
+	tfState.Tags, tfState.TagsAll = r.tags.stateTags(ctx, website.Tags, tfState.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	tfState.Tags = tags
 
 	// Build monitoring configuration from server response
 	monitoring, d := r.buildMonitoringFromServerResponse(ctx, website)
@@ -311,22 +298,36 @@ func (r *websiteResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	// Build the update input
-	var tags []websiteTag
-	d := tfPlan.Tags.ElementsAs(ctx, &tags, false)
+	updateTimeout, d := tfPlan.Timeouts.Update(ctx, websiteDefaultTimeout)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Build the update input
+	tags := r.tags.merged(commonTagsFromSet(ctx, tfPlan.Tags, &resp.Diagnostics))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags that are managed outside terraform are kept.
+	if r.tags.ignoresTags() {
+		current, err := r.client.Dem.GetWebsite(ctx, operations.GetWebsiteRequest{EntityID: tfState.Id.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("error reading website %s before update. err: %s", tfState.Id.ValueString(), err))
+			return
+		}
+		if current.DemGetWebsiteResponse != nil {
+			tags = append(tags, r.tags.ignoredTags(current.DemGetWebsiteResponse.Tags)...)
+		}
+	}
 	updateInput := components.DemWebsite{
 		Name: tfPlan.Name.ValueString(),
 		URL:  tfPlan.Url.ValueString(),
-		Tags: convertArray(tags, func(e websiteTag) components.CommonTag {
-			return components.CommonTag{
-				Key:   e.Key.ValueString(),
-				Value: e.Value.ValueString(),
-			}
-		}),
+		Tags: tags,
 	}
 
 	// Parse monitoring configuration from the plan
@@ -379,22 +380,21 @@ func (r *websiteResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if !tfPlan.Paused.Equal(tfState.Paused) {
+		if err := r.setPaused(ctx, tfState.Id.ValueString(), tfPlan.Paused.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("error updating paused status of website %s. err: %s", tfState.Id.ValueString(), err))
+			return
+		}
+	}
+
 	// Read operation with eventual consistency website validation
 	readOperation := func(ctx context.Context, id string) (*components.DemGetWebsiteResponse, error) {
-		websiteResp, err := r.client.Dem.GetWebsite(ctx, operations.GetWebsiteRequest{
-			EntityID: id,
-		})
-
+		website, err := r.readWebsite(ctx, id)
 		if err != nil {
 			return nil, err
 		}
 
-		if websiteResp.DemGetWebsiteResponse == nil {
-			return nil, ErrNoWebsiteDataReturned
-		}
-
-		website := websiteResp.DemGetWebsiteResponse
-
 		// Validate that the basic fields have been updated
 		expectedName := tfPlan.Name.ValueString()
 		if website.Name != expectedName {
@@ -405,6 +405,10 @@ func (r *websiteResource) Update(ctx context.Context, req resource.UpdateRequest
 			return nil, ErrWebsiteURLNotUpdated
 		}
 
+		if (website.Status == components.DemGetWebsiteResponseStatusPaused) != tfPlan.Paused.ValueBool() {
+			return nil, ErrWebsitePausedNotUpdated
+		}
+
 		// Validate monitoring settings exist if configured in the plan
 		if !tfMonitoring.Availability.IsNull() && website.AvailabilityCheckSettings == nil {
 			return nil, ErrAvailabilitySettingsNotUpdated
@@ -418,7 +422,7 @@ func (r *websiteResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Read the updated website with retry for eventual consistency
-	website, err := websiteReadRetry(ctx, tfState.Id.ValueString(), readOperation)
+	website, err := websiteReadRetry(ctx, tfState.Id.ValueString(), updateTimeout, readOperation)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error",
 			fmt.Sprintf("error reading website after update %s. error: %s", tfState.Id.ValueString(), err))
@@ -428,29 +432,10 @@ func (r *websiteResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update plan with values from the server response
 	tfPlan.Name = types.StringValue(website.Name)
 	tfPlan.Url = types.StringValue(website.URL)
-	var tagElements []attr.Value
-	for _, x := range website.Tags {
-		objectValue, d := types.ObjectValueFrom(
-			ctx,
-			WebsiteTagAttributeTypes(),
-			websiteTag{
-				Key:   types.StringValue(x.Key),
-				Value: types.StringValue(x.Value),
-			},
-		)
-
-		resp.Diagnostics.Append(d...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		tagElements = append(tagElements, objectValue)
-	}
-	websiteTags, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: WebsiteTagAttributeTypes()}, tagElements)
-	resp.Diagnostics.Append(d...)
+	tfState.Tags = commonTagsToSet(ctx, website.Tags, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	tfState.Tags = websiteTags
 
 	// Set computed monitoring options based on user's plan configuration
 	userMonitoringOptions := monitoringOptions{
@@ -504,6 +489,14 @@ func (r *websiteResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, d := tfState.Timeouts.Delete(ctx, websiteDefaultTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	_, err := r.client.Dem.DeleteWebsite(ctx, operations.DeleteWebsiteRequest{
 		EntityID: tfState.Id.ValueString(),
 	})
@@ -518,6 +511,16 @@ func (r *websiteResource) ImportState(ctx context.Context, req resource.ImportSt
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// setPaused pauses or resumes the monitoring of the website.
+func (r *websiteResource) setPaused(ctx context.Context, id string, paused bool) error {
+	if paused {
+		_, err := r.client.Dem.PauseWebsiteMonitoring(ctx, operations.PauseWebsiteMonitoringRequest{EntityID: id})
+		return err
+	}
+	_, err := r.client.Dem.UnpauseWebsiteMonitoring(ctx, operations.UnpauseWebsiteMonitoringRequest{EntityID: id})
+	return err
+}
+
 func stringToOperator(operatorStr string) (components.Operator, error) {
 	if operator, exists := operatorMap[operatorStr]; exists {
 		return operator, nil
@@ -546,7 +549,21 @@ func stringToTestFromType(typeStr string) (components.Type, error) {
 	return "", ErrUnsupportedTestFromType
 }
 
-func websiteReadRetry(ctx context.Context, id string, operation func(context.Context, string) (*components.DemGetWebsiteResponse, error)) (*components.DemGetWebsiteResponse, error) {
+// readWebsite returns the website with the given id.
+func (r *websiteResource) readWebsite(ctx context.Context, id string) (*components.DemGetWebsiteResponse, error) {
+	websiteResp, err := r.client.Dem.GetWebsite(ctx, operations.GetWebsiteRequest{EntityID: id})
+	if err != nil {
+		return nil, err
+	}
+	if websiteResp.DemGetWebsiteResponse == nil {
+		return nil, ErrNoWebsiteDataReturned
+	}
+	return websiteResp.DemGetWebsiteResponse, nil
+}
+
+// websiteReadRetry retries the read operation until the website is returned, or the timeout elapses. Website
+// creates and updates are eventually consistent.
+func websiteReadRetry(ctx context.Context, id string, timeout time.Duration, operation func(context.Context, string) (*components.DemGetWebsiteResponse, error)) (*components.DemGetWebsiteResponse, error) {
 	var website *components.DemGetWebsiteResponse
 
 	expBackoff := backoff.NewExponentialBackOff()
@@ -567,7 +584,7 @@ func websiteReadRetry(ctx context.Context, id string, operation func(context.Con
 		}
 
 		return result, nil
-	}, backoff.WithBackOff(expBackoff), backoff.WithMaxElapsedTime(websiteExpBackoffMaxElapsed))
+	}, backoff.WithBackOff(expBackoff), backoff.WithMaxElapsedTime(timeout))
 
 	return website, err
 }
@@ -711,6 +728,10 @@ func mapAvailabilitySettings(ctx context.Context, tfAvailability availabilityMon
 		availabilitySettings.CustomHeaders = customHeaders
 	}
 
+	if !tfAvailability.PostData.IsNull() {
+		availabilitySettings.PostData = swov1.String(tfAvailability.PostData.ValueString())
+	}
+
 	return availabilitySettings, nil
 }
 
@@ -818,6 +839,7 @@ func (r *websiteResource) buildAvailabilityMonitoring(ctx context.Context, avail
 		PlatformOptions:       types.ObjectNull(PlatformOptionsAttributeTypes()),
 		CustomHeaders:         types.SetNull(types.ObjectType{AttrTypes: CustomHeaderAttributeTypes()}),
 		OutageConfig:          types.ObjectNull(OutageConfigAttributeTypes()),
+		PostData:              types.StringNull(),
 	}
 
 	if availability.OutageConfiguration != nil {
@@ -847,6 +869,11 @@ func (r *websiteResource) buildAvailabilityMonitoring(ctx context.Context, avail
 		tfAvailability.CheckForString = checkForString
 	}
 
+	// Map POST request body, an empty body sends GET requests
+	if availability.PostData != nil && *availability.PostData != "" {
+		tfAvailability.PostData = types.StringValue(*availability.PostData)
+	}
+
 	// Map test interval
 	if availability.TestIntervalInSeconds != 0 {
 		tfAvailability.TestIntervalInSeconds = types.Int64Value(int64(availability.TestIntervalInSeconds))
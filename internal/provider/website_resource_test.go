@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -173,6 +174,7 @@ func TestAccWebsiteResourceMonitoringOptionsComputed(t *testing.T) {
 				resource.TestCheckResourceAttr("swo_website.test", "monitoring.options.is_rum_active", "true"),
 				resource.TestCheckResourceAttr("swo_website.test", "monitoring.availability.check_for_string.operator", "DOES_NOT_CONTAIN"),
 				resource.TestCheckResourceAttr("swo_website.test", "monitoring.availability.check_for_string.value", "error"),
+				resource.TestCheckResourceAttr("swo_website.test", "monitoring.availability.post_data", `{"check":"health"}`),
 				resource.TestCheckResourceAttr("swo_website.test", "monitoring.availability.protocols.0", "HTTPS"),
 				resource.TestCheckResourceAttr("swo_website.test", "monitoring.availability.test_from_location", "REGION"),
 				resource.TestCheckResourceAttr("swo_website.test", "monitoring.availability.location_options.#", "1"),
@@ -203,6 +205,7 @@ func TestAccWebsiteResourceMonitoringOptionsComputed(t *testing.T) {
 				// Verify availability still works
 				resource.TestCheckResourceAttr("swo_website.test", "monitoring.availability.protocols.0", "HTTP"),
 				resource.TestCheckResourceAttr("swo_website.test", "monitoring.availability.test_interval_in_seconds", "600"),
+				resource.TestCheckNoResourceAttr("swo_website.test", "monitoring.availability.post_data"),
 				// Verify RUM is removed
 				resource.TestCheckNoResourceAttr("swo_website.test", "monitoring.rum"),
 			),
@@ -223,6 +226,40 @@ func TestAccWebsiteResourceMonitoringOptionsComputed(t *testing.T) {
 	})
 }
 
+func TestAccWebsiteResourcePaused(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		IsUnitTest:               true,
+		Steps: []resource.TestStep{
+			// Create paused
+			createTestStep(
+				testAccWebsiteResourcePausedConfig(true),
+				"test-acc paused [CREATE_TEST]",
+				"https://example.com",
+				false,
+				websiteMonitoringConfigAvailabilityOnly,
+				resource.TestCheckResourceAttr("swo_website.test", "paused", "true"),
+			),
+			// ImportState testing
+			{
+				ResourceName:      "swo_website.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Resume in place
+			createTestStep(
+				testAccWebsiteResourcePausedConfig(false),
+				"test-acc paused [CREATE_TEST]",
+				"https://example.com",
+				false,
+				websiteMonitoringConfigAvailabilityOnly,
+				resource.TestCheckResourceAttr("swo_website.test", "paused", "false"),
+			),
+		},
+	})
+}
+
 var (
 	websiteMonitoringConfig                           = monitoringConfig(availabilityConfig(true, true, true), "null", true)
 	websiteMonitoringConfigWithoutAvailability        = monitoringConfig("null", rumConfig(), false)
@@ -288,6 +325,14 @@ func testAccWebsiteResourceConfig(name string, url string, monitoring string, in
 	return resourceConfig
 }
 
+func testAccWebsiteResourcePausedConfig(paused bool) func(string, string, string, bool) string {
+	return func(name string, url string, monitoring string, includeTags bool) string {
+		return strings.Replace(testAccWebsiteResourceConfig(name, url, monitoring, includeTags),
+			`resource "swo_website" "test" {`, fmt.Sprintf(`resource "swo_website" "test" {
+		paused = %t`, paused), 1)
+	}
+}
+
 func monitoringConfig(availability, rum string, useDeprecatedCustomHeaders bool) string {
 	monitoringConf := fmt.Sprintf(`{
 		availability = %s
@@ -396,6 +441,7 @@ func availabilityConfigForMonitoringOptionsTest() string {
 			operator = "DOES_NOT_CONTAIN"
 			value    = "error"
 		}
+		post_data                = jsonencode({ check = "health" })
 		protocols                = ["HTTPS"]
 		test_interval_in_seconds = 300
 		test_from_location       = "REGION"
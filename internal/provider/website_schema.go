@@ -3,11 +3,14 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+
 	"github.com/solarwinds/swo-sdk-go/swov1/models/components"
 	"github.com/solarwinds/terraform-provider-swo/internal/planmodifier/stringmodifier"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	swoClient "github.com/solarwinds/swo-client-go/pkg/client"
@@ -23,23 +26,14 @@ import (
 
 // The main Website Resource model that is derived from the schema.
 type websiteResourceModel struct {
-	Id         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	Url        types.String `tfsdk:"url"`
-	Tags       types.Set    `tfsdk:"tags"`
-	Monitoring types.Object `tfsdk:"monitoring"` //websiteMonitoring
-}
-
-type websiteTag struct {
-	Key   types.String `tfsdk:"key"`
-	Value types.String `tfsdk:"value"`
-}
-
-func WebsiteTagAttributeTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"key":   types.StringType,
-		"value": types.StringType,
-	}
+	Id         types.String   `tfsdk:"id"`
+	Name       types.String   `tfsdk:"name"`
+	Url        types.String   `tfsdk:"url"`
+	Tags       types.Set      `tfsdk:"tags"`
+	TagsAll    types.Set      `tfsdk:"tags_all"`
+	Paused     types.Bool     `tfsdk:"paused"`
+	Monitoring types.Object   `tfsdk:"monitoring"` //websiteMonitoring
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
 
 type websiteMonitoring struct {
@@ -80,6 +74,7 @@ type availabilityMonitoring struct {
 	PlatformOptions       types.Object `tfsdk:"platform_options"`
 	CustomHeaders         types.Set    `tfsdk:"custom_headers"`
 	OutageConfig          types.Object `tfsdk:"outage_configuration"`
+	PostData              types.String `tfsdk:"post_data"`
 }
 
 func AvailabilityMonitoringAttributeTypes() map[string]attr.Type {
@@ -93,6 +88,7 @@ func AvailabilityMonitoringAttributeTypes() map[string]attr.Type {
 		"platform_options":         types.ObjectType{AttrTypes: PlatformOptionsAttributeTypes()},
 		"custom_headers":           types.SetType{ElemType: types.ObjectType{AttrTypes: CustomHeaderAttributeTypes()}},
 		"outage_configuration":     types.ObjectType{AttrTypes: OutageConfigAttributeTypes()},
+		"post_data":                types.StringType,
 	}
 }
 
@@ -184,7 +180,7 @@ func CheckForStringTypeAttributeTypes() map[string]attr.Type {
 	}
 }
 
-func (r *websiteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *websiteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A terraform resource for managing website uptime checks.",
 		Attributes: map[string]schema.Attribute{
@@ -197,20 +193,9 @@ func (r *websiteResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "The Url to monitor.",
 				Required:    true,
 			},
-			"tags": schema.SetNestedAttribute{
-				Description: "Entity tags. Tag is a key-value pair, where there may be only single tag value for the same key.",
-				Optional:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"key": schema.StringAttribute{
-							Required: true,
-						},
-						"value": schema.StringAttribute{
-							Required: true,
-						},
-					},
-				},
-			},
+			"tags":     entityTagsAttribute(),
+			"tags_all": entityTagsAllAttribute(),
+			"paused":   entityPausedAttribute(),
 			"monitoring": schema.SingleNestedAttribute{
 				Description: "The Website monitoring settings.",
 				Required:    true,
@@ -351,6 +336,7 @@ func (r *websiteResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 										"value": schema.StringAttribute{
 											Description: "The Website custom header value.",
 											Required:    true,
+											Sensitive:   true,
 										},
 									},
 								},
@@ -377,6 +363,16 @@ func (r *websiteResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 									},
 								},
 							},
+							"post_data": schema.StringAttribute{
+								Description: "The data to send as the body of a POST request, e.g. a JSON document. " +
+									"If omitted, the availability test sends GET requests. Credentials, e.g. a bearer token, " +
+									"can be sent with `custom_headers`.",
+								Optional:  true,
+								Sensitive: true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtLeast(1),
+								},
+							},
 						},
 					},
 					"rum": schema.SingleNestedAttribute{
@@ -424,6 +420,7 @@ func (r *websiteResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 								"value": schema.StringAttribute{
 									Description: "The Website custom header value.",
 									Required:    true,
+									Sensitive:   true,
 								},
 							},
 						},
@@ -431,5 +428,13 @@ func (r *websiteResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }